@@ -0,0 +1,164 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+)
+
+const checkAPILong = `
+Check that the cert-manager API is ready to be used.
+
+This verifies that all of the cert-manager resource types are registered with
+the Kubernetes API server, and that Certificates can be listed.`
+
+// checkAPIPollInterval is how often the API is re-checked when --wait is set.
+const checkAPIPollInterval = 5 * time.Second
+
+// requiredAPIResources are the resources that must be served by the
+// cert-manager API group for the API to be considered ready.
+var requiredAPIResources = []string{
+	"certificates",
+	"certificaterequests",
+	"challenges",
+	"clusterissuers",
+	"issuers",
+	"orders",
+}
+
+// NewCmdCheck returns a cobra command grouping the check subcommands.
+func NewCmdCheck(f *Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check cert-manager components",
+	}
+
+	cmd.AddCommand(NewCmdCheckAPI(f, out))
+
+	return cmd
+}
+
+// CheckAPIOptions holds the options for the check api command
+type CheckAPIOptions struct {
+	Namespace string
+	Wait      time.Duration
+
+	DiscoveryClient discovery.DiscoveryInterface
+	CMClient        cmclient.Interface
+	Out             io.Writer
+}
+
+// NewCmdCheckAPI returns a cobra command that checks whether the cert-manager
+// API is available.
+func NewCmdCheckAPI(f *Factory, out io.Writer) *cobra.Command {
+	o := &CheckAPIOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:   "api",
+		Short: "Check if the cert-manager API is ready",
+		Long:  checkAPILong,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(f); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+	}
+
+	cmd.Flags().DurationVar(&o.Wait, "wait", 0, ""+
+		"Wait until the cert-manager API is ready, or the given duration has elapsed")
+
+	return cmd
+}
+
+// Complete builds the clients and namespace used by Run.
+func (o *CheckAPIOptions) Complete(f *Factory) error {
+	var err error
+	if o.Namespace, err = f.Namespace(); err != nil {
+		return err
+	}
+	kubeClient, err := f.KubeClient()
+	if err != nil {
+		return err
+	}
+	o.DiscoveryClient = kubeClient.Discovery()
+	if o.CMClient, err = f.CMClient(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Run checks the cert-manager API, retrying until the --wait duration has
+// elapsed if it is set.
+func (o *CheckAPIOptions) Run() error {
+	if o.Wait <= 0 {
+		if err := o.check(); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "The cert-manager API is ready\n")
+		return nil
+	}
+
+	var lastErr error
+	err := wait.PollImmediate(checkAPIPollInterval, o.Wait, func() (bool, error) {
+		if lastErr = o.check(); lastErr != nil {
+			fmt.Fprintf(o.Out, "Not ready: %v\n", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("timed out waiting for the cert-manager API: %v", lastErr)
+	}
+
+	fmt.Fprintf(o.Out, "The cert-manager API is ready\n")
+	return nil
+}
+
+func (o *CheckAPIOptions) check() error {
+	gv := cmapi.SchemeGroupVersion.String()
+	resources, err := o.DiscoveryClient.ServerResourcesForGroupVersion(gv)
+	if err != nil {
+		return fmt.Errorf("the cert-manager API group %q is not available: %v", gv, err)
+	}
+
+	served := make(map[string]bool)
+	for _, r := range resources.APIResources {
+		served[r.Name] = true
+	}
+	for _, r := range requiredAPIResources {
+		if !served[r] {
+			return fmt.Errorf("the cert-manager API group %q does not serve the %q resource", gv, r)
+		}
+	}
+
+	if _, err := o.CMClient.CertmanagerV1alpha1().Certificates(o.Namespace).List(metav1.ListOptions{}); err != nil {
+		return fmt.Errorf("error listing Certificates: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestCheckAPIRun(t *testing.T) {
+	apiResources := func(names ...string) []*metav1.APIResourceList {
+		list := &metav1.APIResourceList{GroupVersion: cmapi.SchemeGroupVersion.String()}
+		for _, n := range names {
+			list.APIResources = append(list.APIResources, metav1.APIResource{Name: n})
+		}
+		return []*metav1.APIResourceList{list}
+	}
+
+	tests := map[string]struct {
+		resources []*metav1.APIResourceList
+		wantErr   bool
+	}{
+		"ready if all resources are served": {
+			resources: apiResources(requiredAPIResources...),
+		},
+		"not ready if the API group is not served": {
+			wantErr: true,
+		},
+		"not ready if a resource is missing": {
+			resources: apiResources("certificates", "issuers"),
+			wantErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			discovery := kubefake.NewSimpleClientset().Discovery().(*fakediscovery.FakeDiscovery)
+			discovery.Resources = test.resources
+
+			o := &CheckAPIOptions{
+				Namespace:       gen.DefaultTestNamespace,
+				DiscoveryClient: discovery,
+				CMClient:        cmfake.NewSimpleClientset(),
+				Out:             &bytes.Buffer{},
+			}
+
+			err := o.Run()
+			if (err != nil) != test.wantErr {
+				t.Errorf("expected error=%t, got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jetstack/cert-manager/pkg/util"
+)
+
+// NewCommandCertManagerCtl returns the root cmctl command, with all of its
+// subcommands registered.
+func NewCommandCertManagerCtl(in io.Reader, out, errOut io.Writer) *cobra.Command {
+	f := NewFactory()
+
+	cmd := &cobra.Command{
+		Use:   "cmctl",
+		Short: fmt.Sprintf("Command line tool to manage cert-manager resources (%s) (%s)", util.AppVersion, util.AppGitCommit),
+		Long: `
+cmctl is a command line tool for inspecting and operating the resources
+managed by cert-manager.
+
+If the binary is installed on your PATH as 'kubectl-cert_manager', it can also
+be invoked as a kubectl plugin using 'kubectl cert-manager'.`,
+		SilenceUsage: true,
+	}
+	cmd.SetOutput(errOut)
+
+	f.AddFlags(cmd.PersistentFlags())
+
+	cmd.AddCommand(NewCmdStatus(f, out))
+	cmd.AddCommand(NewCmdRenew(f, out))
+	cmd.AddCommand(NewCmdRevoke(f, out))
+	cmd.AddCommand(NewCmdInspect(f, out))
+	cmd.AddCommand(NewCmdCheck(f, out))
+	cmd.AddCommand(NewCmdConvert(in, out, errOut))
+
+	return cmd
+}
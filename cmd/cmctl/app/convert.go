@@ -0,0 +1,527 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kjson "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+// legacyGroupName is the API group that cert-manager resources were served in
+// before they were moved to the cert-manager.io API group.
+const legacyGroupName = "certmanager.k8s.io"
+
+const convertLong = `
+Convert Issuer, ClusterIssuer and Certificate manifests that use the deprecated
+ACME 'http01' and 'dns01' Issuer fields and the Certificate 'acme.config' field
+to the 'solvers' format.
+
+Resources in the old 'certmanager.k8s.io' API group are moved to the
+'cert-manager.io' API group, keeping their API version.
+
+Each Certificate's ACME domain configuration is converted into a solver on the
+Issuer it references, with a 'selector' listing the domains it applies to. As
+Certificates are converted using the configuration of the Issuer they
+reference, both the Issuer and its Certificates must be provided as input.
+
+The deprecated Issuer configuration is kept alongside the generated solvers, as
+it is still used by Certificates created from Ingress resources using the
+ingress-shim 'certmanager.k8s.io/acme-challenge-type' annotation. Use
+--strip-deprecated to remove the configuration that has been converted.
+
+The conversion is performed offline, and the converted manifests are written to
+stdout. Any configuration that cannot be expressed in the new format is
+reported on stderr and left unchanged.`
+
+// ConvertOptions holds the options for the convert command
+type ConvertOptions struct {
+	Filenames []string
+	// StripDeprecated removes the deprecated Issuer configuration once it has
+	// been converted into a solver
+	StripDeprecated bool
+
+	In     io.Reader
+	Out    io.Writer
+	ErrOut io.Writer
+}
+
+// NewCmdConvert returns a cobra command that converts deprecated ACME
+// configuration to the solvers format.
+func NewCmdConvert(in io.Reader, out, errOut io.Writer) *cobra.Command {
+	o := &ConvertOptions{In: in, Out: out, ErrOut: errOut}
+
+	cmd := &cobra.Command{
+		Use:   "convert -f FILENAME",
+		Short: "Convert deprecated ACME configuration to the solvers format",
+		Long:  convertLong,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(o.Filenames) == 0 {
+				return fmt.Errorf("please specify at least one file to convert using --filename")
+			}
+			return o.Run()
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&o.Filenames, "filename", "f", nil, ""+
+		"Filename of the manifests to convert, or '-' to read from stdin. May be specified multiple times")
+	cmd.Flags().BoolVar(&o.StripDeprecated, "strip-deprecated", false, ""+
+		"Remove the deprecated http01 and dns01 Issuer configuration once it has been converted to solvers")
+
+	return cmd
+}
+
+// Run reads all of the input manifests, converts them and writes them to Out.
+func (o *ConvertOptions) Run() error {
+	var docs []*unstructured.Unstructured
+	for _, filename := range o.Filenames {
+		fileDocs, err := o.readFile(filename)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, fileDocs...)
+	}
+
+	convertAPIGroup(docs)
+	warnings, err := convertACMEConfig(docs, o.StripDeprecated)
+	if err != nil {
+		return err
+	}
+	for _, w := range warnings {
+		fmt.Fprintf(o.ErrOut, "warning: %s\n", w)
+	}
+
+	return writeManifests(o.Out, docs)
+}
+
+// readFile reads all of the manifests in the named file, or from In if
+// filename is '-'. The file is closed once it has been read.
+func (o *ConvertOptions) readFile(filename string) ([]*unstructured.Unstructured, error) {
+	var r io.Reader = o.In
+	if filename != "-" {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	docs, err := readManifests(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %q: %v", filename, err)
+	}
+	return docs, nil
+}
+
+// readManifests reads all of the YAML or JSON documents in r.
+func readManifests(r io.Reader) ([]*unstructured.Unstructured, error) {
+	var docs []*unstructured.Unstructured
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		data, err := reader.Read()
+		if err == io.EOF {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		jsonData, err := utilyaml.ToJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		obj := make(map[string]interface{})
+		if err := json.Unmarshal(jsonData, &obj); err != nil {
+			return nil, err
+		}
+		// skip empty documents
+		if len(obj) == 0 {
+			continue
+		}
+		docs = append(docs, &unstructured.Unstructured{Object: obj})
+	}
+}
+
+// writeManifests writes docs to w as a multi-document YAML stream.
+func writeManifests(w io.Writer, docs []*unstructured.Unstructured) error {
+	serializer := kjson.NewYAMLSerializer(kjson.DefaultMetaFactory, nil, nil)
+	for i, doc := range docs {
+		if i > 0 {
+			fmt.Fprintf(w, "---\n")
+		}
+		if err := serializer.Encode(doc, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertAPIGroup moves the given resources from the legacy API group to the
+// cert-manager.io API group in place. Documents in other API groups are left
+// untouched.
+func convertAPIGroup(docs []*unstructured.Unstructured) {
+	for _, doc := range docs {
+		gv, err := schema.ParseGroupVersion(doc.GetAPIVersion())
+		if err != nil || gv.Group != legacyGroupName {
+			continue
+		}
+		gv.Group = cmapi.SchemeGroupVersion.Group
+		doc.SetAPIVersion(gv.String())
+	}
+}
+
+// issuerConversion tracks the conversion of a single Issuer or ClusterIssuer.
+type issuerConversion struct {
+	doc    *unstructured.Unstructured
+	issuer cmapi.GenericIssuer
+
+	// solvers are the solvers generated from the Certificates that reference
+	// this issuer, in the order they were first seen
+	solvers []cmapi.ACMEChallengeSolver
+	// domainSolver maps each domain to the index of the solver in solvers
+	// that is used to solve it
+	domainSolver map[string]int
+
+	// usedHTTP01 and usedProviders record the deprecated configuration that
+	// has been converted into a solver.
+	usedHTTP01    bool
+	usedProviders map[string]bool
+	// neededHTTP01 and neededProviders record the deprecated configuration
+	// that is still referenced by a Certificate that could not be converted.
+	neededHTTP01    bool
+	neededProviders map[string]bool
+}
+
+// convertACMEConfig converts the deprecated ACME configuration of the given
+// Issuer, ClusterIssuer and Certificate manifests in place. Documents that are
+// not cert-manager resources are left untouched. The deprecated Issuer
+// configuration is only removed if stripDeprecated is true.
+// It returns a list of warnings describing any configuration that could not be
+// converted.
+func convertACMEConfig(docs []*unstructured.Unstructured, stripDeprecated bool) ([]string, error) {
+	var warnings []string
+	issuers := make(map[string]*issuerConversion)
+	var issuerOrder []string
+
+	for _, doc := range docs {
+		if doc.GetAPIVersion() != cmapi.SchemeGroupVersion.String() {
+			continue
+		}
+
+		var issuer cmapi.GenericIssuer
+		switch doc.GetKind() {
+		case cmapi.IssuerKind:
+			issuer = &cmapi.Issuer{}
+		case cmapi.ClusterIssuerKind:
+			issuer = &cmapi.ClusterIssuer{}
+		default:
+			continue
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(doc.Object, issuer); err != nil {
+			return nil, fmt.Errorf("error decoding %s %q: %v", doc.GetKind(), doc.GetName(), err)
+		}
+
+		acme := issuer.GetSpec().ACME
+		if acme == nil || (acme.HTTP01 == nil && acme.DNS01 == nil) {
+			continue
+		}
+
+		key := issuerKey(doc.GetKind(), doc.GetNamespace(), doc.GetName())
+		issuers[key] = &issuerConversion{
+			doc:             doc,
+			issuer:          issuer,
+			domainSolver:    make(map[string]int),
+			usedProviders:   make(map[string]bool),
+			neededProviders: make(map[string]bool),
+		}
+		issuerOrder = append(issuerOrder, key)
+	}
+
+	for _, doc := range docs {
+		if doc.GetAPIVersion() != cmapi.SchemeGroupVersion.String() || doc.GetKind() != cmapi.CertificateKind {
+			continue
+		}
+
+		crt := &cmapi.Certificate{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(doc.Object, crt); err != nil {
+			return nil, fmt.Errorf("error decoding Certificate %q: %v", doc.GetName(), err)
+		}
+		if crt.Spec.ACME == nil {
+			continue
+		}
+
+		converted, ws := convertCertificate(crt, issuers)
+		warnings = append(warnings, ws...)
+		if converted {
+			unstructured.RemoveNestedField(doc.Object, "spec", "acme")
+		}
+	}
+
+	for _, key := range issuerOrder {
+		ws, err := convertIssuer(issuers[key], stripDeprecated)
+		if err != nil {
+			return nil, err
+		}
+		warnings = append(warnings, ws...)
+	}
+
+	return warnings, nil
+}
+
+// convertCertificate records solvers on the referenced issuer for each of the
+// Certificate's deprecated ACME domain configurations. It returns true if all
+// of the Certificate's configuration could be converted.
+func convertCertificate(crt *cmapi.Certificate, issuers map[string]*issuerConversion) (bool, []string) {
+	crtName := objectName(crt.Namespace, crt.Name)
+	ref := crt.Spec.IssuerRef
+	kind := ref.Kind
+	if kind == "" {
+		kind = cmapi.IssuerKind
+	}
+	namespace := crt.Namespace
+	if kind == cmapi.ClusterIssuerKind {
+		namespace = ""
+	}
+
+	ic, ok := issuers[issuerKey(kind, namespace, ref.Name)]
+	if !ok {
+		return false, []string{fmt.Sprintf("Certificate %s: %s %s with deprecated ACME configuration not found in input, "+
+			"Certificate has not been converted", crtName, kind, objectName(namespace, ref.Name))}
+	}
+	acme := ic.issuer.GetSpec().ACME
+
+	var warnings []string
+	var solvers []cmapi.ACMEChallengeSolver
+	var domains [][]string
+	for _, cfg := range crt.Spec.ACME.Config {
+		solver, err := solverForDomainConfig(acme, cfg)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("Certificate %s: domains %s: %v", crtName, strings.Join(cfg.Domains, ", "), err))
+			continue
+		}
+		for _, d := range cfg.Domains {
+			if i, ok := ic.domainSolver[d]; ok && !reflect.DeepEqual(ic.solvers[i], solver) {
+				warnings = append(warnings, fmt.Sprintf("Certificate %s: domain %q is solved differently by another Certificate "+
+					"using the same issuer, which cannot be expressed using a solver selector", crtName, d))
+			}
+		}
+		solvers = append(solvers, solver)
+		domains = append(domains, cfg.Domains)
+	}
+
+	if len(warnings) > 0 {
+		// Leave the Certificate untouched, and ensure the deprecated issuer
+		// configuration it depends on is kept.
+		for _, cfg := range crt.Spec.ACME.Config {
+			if cfg.HTTP01 != nil {
+				ic.neededHTTP01 = true
+			}
+			if cfg.DNS01 != nil {
+				ic.neededProviders[cfg.DNS01.Provider] = true
+			}
+		}
+		return false, append(warnings, fmt.Sprintf("Certificate %s has not been converted", crtName))
+	}
+
+	for i, solver := range solvers {
+		idx := -1
+		for j, s := range ic.solvers {
+			if reflect.DeepEqual(s, solver) {
+				idx = j
+				break
+			}
+		}
+		if idx == -1 {
+			ic.solvers = append(ic.solvers, solver)
+			idx = len(ic.solvers) - 1
+		}
+		for _, d := range domains[i] {
+			ic.domainSolver[d] = idx
+		}
+	}
+	for _, cfg := range crt.Spec.ACME.Config {
+		if cfg.HTTP01 != nil {
+			ic.usedHTTP01 = true
+		}
+		if cfg.DNS01 != nil {
+			ic.usedProviders[cfg.DNS01.Provider] = true
+		}
+	}
+
+	return true, nil
+}
+
+// solverForDomainConfig returns the solver equivalent to the given deprecated
+// domain configuration, without a selector.
+func solverForDomainConfig(acme *cmapi.ACMEIssuer, cfg cmapi.DomainSolverConfig) (cmapi.ACMEChallengeSolver, error) {
+	switch {
+	case cfg.HTTP01 != nil && cfg.DNS01 != nil:
+		return cmapi.ACMEChallengeSolver{}, fmt.Errorf("only one of http01 or dns01 may be specified")
+	case cfg.HTTP01 != nil:
+		if acme.HTTP01 == nil {
+			return cmapi.ACMEChallengeSolver{}, fmt.Errorf("http01 is not enabled on the issuer")
+		}
+		return cmapi.ACMEChallengeSolver{
+			HTTP01: &cmapi.ACMEChallengeSolverHTTP01{
+				Ingress: &cmapi.ACMEChallengeSolverHTTP01Ingress{
+					ServiceType: acme.HTTP01.ServiceType,
+					Class:       cfg.HTTP01.IngressClass,
+					Name:        cfg.HTTP01.Ingress,
+				},
+			},
+		}, nil
+	case cfg.DNS01 != nil:
+		p, err := acme.DNS01.Provider(cfg.DNS01.Provider)
+		if err != nil {
+			return cmapi.ACMEChallengeSolver{}, err
+		}
+		return cmapi.ACMEChallengeSolver{
+			DNS01: &cmapi.ACMEChallengeSolverDNS01{
+				CNAMEStrategy: p.CNAMEStrategy,
+				Akamai:        p.Akamai,
+				CloudDNS:      p.CloudDNS,
+				Cloudflare:    p.Cloudflare,
+				Route53:       p.Route53,
+				AzureDNS:      p.AzureDNS,
+				DigitalOcean:  p.DigitalOcean,
+				AcmeDNS:       p.AcmeDNS,
+				RFC2136:       p.RFC2136,
+				Webhook:       p.Webhook,
+			},
+		}, nil
+	default:
+		return cmapi.ACMEChallengeSolver{}, fmt.Errorf("one of http01 or dns01 must be specified")
+	}
+}
+
+// convertIssuer adds the solvers generated for an issuer to its manifest. If
+// stripDeprecated is true, it also removes the deprecated configuration that
+// is no longer used by any Certificate in the input.
+func convertIssuer(ic *issuerConversion, stripDeprecated bool) ([]string, error) {
+	var warnings []string
+	name := fmt.Sprintf("%s %s", ic.doc.GetKind(), objectName(ic.doc.GetNamespace(), ic.doc.GetName()))
+	acme := ic.issuer.GetSpec().ACME
+
+	for i := range ic.solvers {
+		var dnsNames []string
+		for d, idx := range ic.domainSolver {
+			if idx == i {
+				dnsNames = append(dnsNames, d)
+			}
+		}
+		sort.Strings(dnsNames)
+		ic.solvers[i].Selector = &cmapi.CertificateDNSNameSelector{DNSNames: dnsNames}
+	}
+	acme.Solvers = append(acme.Solvers, ic.solvers...)
+
+	if stripDeprecated {
+		warnings = append(warnings, stripDeprecatedConfig(ic, name)...)
+	}
+
+	// Write the converted ACME configuration back into the manifest, leaving
+	// all other fields untouched.
+	data, err := json.Marshal(acme)
+	if err != nil {
+		return nil, err
+	}
+	converted := make(map[string]interface{})
+	if err := json.Unmarshal(data, &converted); err != nil {
+		return nil, err
+	}
+	for _, field := range []string{"solvers", "http01", "dns01"} {
+		if v, ok := converted[field]; ok {
+			if err := unstructured.SetNestedField(ic.doc.Object, v, "spec", "acme", field); err != nil {
+				return nil, err
+			}
+		} else {
+			unstructured.RemoveNestedField(ic.doc.Object, "spec", "acme", field)
+		}
+	}
+
+	return warnings, nil
+}
+
+// stripDeprecatedConfig removes the deprecated configuration of an issuer
+// that has been converted into a solver, and is not needed by any Certificate
+// that could not be converted.
+// Certificates created by ingress-shim still use the deprecated configuration
+// named by the annotations on their Ingress, so a warning is returned for each
+// part of the configuration that is removed.
+func stripDeprecatedConfig(ic *issuerConversion, name string) []string {
+	var warnings []string
+	acme := ic.issuer.GetSpec().ACME
+
+	if acme.HTTP01 != nil && !ic.neededHTTP01 {
+		if ic.usedHTTP01 {
+			acme.HTTP01 = nil
+			warnings = append(warnings, fmt.Sprintf("%s: http01 has been removed, Ingresses using this issuer with the "+
+				"'certmanager.k8s.io/acme-challenge-type: http01' annotation will use the issuer's solvers instead, "+
+				"and the 'certmanager.k8s.io/acme-http01-edit-in-place' annotation is no longer supported", name))
+		} else {
+			warnings = append(warnings, fmt.Sprintf("%s: http01 is not used by any Certificate in the input, "+
+				"and has been left unchanged", name))
+		}
+	}
+
+	if acme.DNS01 != nil {
+		var remaining []cmapi.ACMEIssuerDNS01Provider
+		for _, p := range acme.DNS01.Providers {
+			if ic.usedProviders[p.Name] && !ic.neededProviders[p.Name] {
+				warnings = append(warnings, fmt.Sprintf("%s: dns01 provider %q has been removed, Ingresses using this issuer "+
+					"with the 'certmanager.k8s.io/acme-dns01-provider: %s' annotation must be updated to use the issuer's solvers", name, p.Name, p.Name))
+				continue
+			}
+			if !ic.usedProviders[p.Name] && !ic.neededProviders[p.Name] {
+				warnings = append(warnings, fmt.Sprintf("%s: dns01 provider %q is not used by any Certificate in the input, "+
+					"and has been left unchanged", name, p.Name))
+			}
+			remaining = append(remaining, p)
+		}
+		acme.DNS01.Providers = remaining
+		if len(remaining) == 0 {
+			acme.DNS01 = nil
+		}
+	}
+
+	return warnings
+}
+
+func issuerKey(kind, namespace, name string) string {
+	return kind + "/" + objectName(namespace, name)
+}
+
+func objectName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
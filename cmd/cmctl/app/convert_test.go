@@ -0,0 +1,461 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const convertTestIssuer = `apiVersion: cert-manager.io/v1alpha1
+kind: Issuer
+metadata:
+  name: letsencrypt
+  namespace: default
+spec:
+  acme:
+    server: https://acme.example.com
+    privateKeySecretRef:
+      name: letsencrypt
+    http01:
+      serviceType: NodePort
+    dns01:
+      providers:
+      - name: cloudflare
+        cloudflare:
+          email: test@example.com
+          apiKeySecretRef:
+            name: cloudflare
+            key: api-key
+`
+
+const convertTestIssuerUnchanged = `apiVersion: cert-manager.io/v1alpha1
+kind: Issuer
+metadata:
+  name: letsencrypt
+  namespace: default
+spec:
+  acme:
+    dns01:
+      providers:
+      - cloudflare:
+          apiKeySecretRef:
+            key: api-key
+            name: cloudflare
+          email: test@example.com
+        name: cloudflare
+    http01:
+      serviceType: NodePort
+    privateKeySecretRef:
+      name: letsencrypt
+    server: https://acme.example.com
+`
+
+const convertTestCertificates = `---
+apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: a
+  namespace: default
+spec:
+  secretName: a-tls
+  issuerRef:
+    name: letsencrypt
+  dnsNames: [a.example.com, b.example.com]
+  acme:
+    config:
+    - http01:
+        ingressClass: nginx
+      domains: [a.example.com]
+    - dns01:
+        provider: cloudflare
+      domains: [b.example.com]
+---
+apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: c
+  namespace: default
+spec:
+  secretName: c-tls
+  issuerRef:
+    name: letsencrypt
+  dnsNames: [c.example.com]
+  acme:
+    config:
+    - http01:
+        ingressClass: nginx
+      domains: [c.example.com]
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+`
+
+const convertTestCertificatesConverted = `---
+apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: a
+  namespace: default
+spec:
+  dnsNames:
+  - a.example.com
+  - b.example.com
+  issuerRef:
+    name: letsencrypt
+  secretName: a-tls
+---
+apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: c
+  namespace: default
+spec:
+  dnsNames:
+  - c.example.com
+  issuerRef:
+    name: letsencrypt
+  secretName: c-tls
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+`
+
+func TestConvertRun(t *testing.T) {
+	tests := map[string]struct {
+		input           string
+		stripDeprecated bool
+		expOutput       string
+		expWarnings     []string
+	}{
+		"convert an issuer and the certificates that reference it, keeping the deprecated configuration": {
+			input: convertTestIssuer + convertTestCertificates,
+			expOutput: `apiVersion: cert-manager.io/v1alpha1
+kind: Issuer
+metadata:
+  name: letsencrypt
+  namespace: default
+spec:
+  acme:
+    dns01:
+      providers:
+      - cloudflare:
+          apiKeySecretRef:
+            key: api-key
+            name: cloudflare
+          email: test@example.com
+        name: cloudflare
+    http01:
+      serviceType: NodePort
+    privateKeySecretRef:
+      name: letsencrypt
+    server: https://acme.example.com
+    solvers:
+    - http01:
+        ingress:
+          class: nginx
+          serviceType: NodePort
+      selector:
+        dnsNames:
+        - a.example.com
+        - c.example.com
+    - dns01:
+        cloudflare:
+          apiKeySecretRef:
+            key: api-key
+            name: cloudflare
+          email: test@example.com
+      selector:
+        dnsNames:
+        - b.example.com
+` + convertTestCertificatesConverted,
+		},
+		"convert an issuer and the certificates that reference it, removing the deprecated configuration": {
+			input:           convertTestIssuer + convertTestCertificates,
+			stripDeprecated: true,
+			expOutput: `apiVersion: cert-manager.io/v1alpha1
+kind: Issuer
+metadata:
+  name: letsencrypt
+  namespace: default
+spec:
+  acme:
+    privateKeySecretRef:
+      name: letsencrypt
+    server: https://acme.example.com
+    solvers:
+    - http01:
+        ingress:
+          class: nginx
+          serviceType: NodePort
+      selector:
+        dnsNames:
+        - a.example.com
+        - c.example.com
+    - dns01:
+        cloudflare:
+          apiKeySecretRef:
+            key: api-key
+            name: cloudflare
+          email: test@example.com
+      selector:
+        dnsNames:
+        - b.example.com
+` + convertTestCertificatesConverted,
+			expWarnings: []string{
+				"Issuer default/letsencrypt: http01 has been removed",
+				`Issuer default/letsencrypt: dns01 provider "cloudflare" has been removed`,
+			},
+		},
+		"move resources from the legacy API group": {
+			input: `apiVersion: certmanager.k8s.io/v1alpha1
+kind: Certificate
+metadata:
+  name: a
+  namespace: default
+spec:
+  secretName: a-tls
+  issuerRef:
+    name: ca
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+`,
+			expOutput: `apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: a
+  namespace: default
+spec:
+  issuerRef:
+    name: ca
+  secretName: a-tls
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+`,
+		},
+		"do not convert a certificate whose issuer is not in the input": {
+			input: `apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: a
+  namespace: default
+spec:
+  secretName: a-tls
+  issuerRef:
+    name: letsencrypt
+    kind: ClusterIssuer
+  acme:
+    config:
+    - http01: {}
+      domains: [a.example.com]
+`,
+			expOutput: `apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: a
+  namespace: default
+spec:
+  acme:
+    config:
+    - domains:
+      - a.example.com
+      http01: {}
+  issuerRef:
+    kind: ClusterIssuer
+    name: letsencrypt
+  secretName: a-tls
+`,
+			expWarnings: []string{
+				"Certificate default/a: ClusterIssuer letsencrypt with deprecated ACME configuration not found in input",
+			},
+		},
+		"report unknown dns01 providers and unused issuer configuration": {
+			stripDeprecated: true,
+			input: convertTestIssuer + `---
+apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: a
+  namespace: default
+spec:
+  secretName: a-tls
+  issuerRef:
+    name: letsencrypt
+  acme:
+    config:
+    - dns01:
+        provider: route53
+      domains: [a.example.com]
+`,
+			expOutput: convertTestIssuerUnchanged + `---
+apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: a
+  namespace: default
+spec:
+  acme:
+    config:
+    - dns01:
+        provider: route53
+      domains:
+      - a.example.com
+  issuerRef:
+    name: letsencrypt
+  secretName: a-tls
+`,
+			expWarnings: []string{
+				`Certificate default/a: domains a.example.com: issuer does not contain DNS01 configuration for provider named "route53"`,
+				"Certificate default/a has not been converted",
+				"Issuer default/letsencrypt: http01 is not used by any Certificate in the input",
+				`Issuer default/letsencrypt: dns01 provider "cloudflare" is not used by any Certificate in the input`,
+			},
+		},
+		"do not convert a certificate that solves a domain differently to another": {
+			stripDeprecated: true,
+			input: convertTestIssuer + `---
+apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: a
+  namespace: default
+spec:
+  secretName: a-tls
+  issuerRef:
+    name: letsencrypt
+  acme:
+    config:
+    - dns01:
+        provider: cloudflare
+      domains: [a.example.com]
+---
+apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: b
+  namespace: default
+spec:
+  secretName: b-tls
+  issuerRef:
+    name: letsencrypt
+  acme:
+    config:
+    - http01: {}
+      domains: [a.example.com]
+`,
+			expOutput: `apiVersion: cert-manager.io/v1alpha1
+kind: Issuer
+metadata:
+  name: letsencrypt
+  namespace: default
+spec:
+  acme:
+    http01:
+      serviceType: NodePort
+    privateKeySecretRef:
+      name: letsencrypt
+    server: https://acme.example.com
+    solvers:
+    - dns01:
+        cloudflare:
+          apiKeySecretRef:
+            key: api-key
+            name: cloudflare
+          email: test@example.com
+      selector:
+        dnsNames:
+        - a.example.com
+---
+apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: a
+  namespace: default
+spec:
+  issuerRef:
+    name: letsencrypt
+  secretName: a-tls
+---
+apiVersion: cert-manager.io/v1alpha1
+kind: Certificate
+metadata:
+  name: b
+  namespace: default
+spec:
+  acme:
+    config:
+    - domains:
+      - a.example.com
+      http01: {}
+  issuerRef:
+    name: letsencrypt
+  secretName: b-tls
+`,
+			expWarnings: []string{
+				`Certificate default/b: domain "a.example.com" is solved differently by another Certificate using the same issuer`,
+				"Certificate default/b has not been converted",
+				`Issuer default/letsencrypt: dns01 provider "cloudflare" has been removed`,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			errOut := &bytes.Buffer{}
+			o := &ConvertOptions{
+				Filenames:       []string{"-"},
+				StripDeprecated: test.stripDeprecated,
+				In:              strings.NewReader(test.input),
+				Out:             out,
+				ErrOut:          errOut,
+			}
+
+			if err := o.Run(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if out.String() != test.expOutput {
+				t.Errorf("unexpected output, exp:\n%s\ngot:\n%s", test.expOutput, out.String())
+			}
+
+			warnings := strings.Split(strings.TrimSpace(errOut.String()), "\n")
+			if errOut.Len() == 0 {
+				warnings = nil
+			}
+			if len(warnings) != len(test.expWarnings) {
+				t.Fatalf("expected %d warnings, got:\n%s", len(test.expWarnings), errOut.String())
+			}
+			for i, w := range test.expWarnings {
+				if !strings.Contains(warnings[i], w) {
+					t.Errorf("expected warning %d to contain %q, got %q", i, w, warnings[i])
+				}
+			}
+		})
+	}
+}
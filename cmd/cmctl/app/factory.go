@@ -0,0 +1,104 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+)
+
+// Factory provides the Kubernetes and cert-manager clients used by each
+// cmctl command. Clients are built lazily from the user's kubeconfig, and the
+// usual kubectl flags (--kubeconfig, --context, --namespace etc.) may be used
+// to override the loaded configuration.
+type Factory struct {
+	loadingRules *clientcmd.ClientConfigLoadingRules
+	overrides    *clientcmd.ConfigOverrides
+
+	clientConfig clientcmd.ClientConfig
+	restConfig   *rest.Config
+}
+
+// NewFactory returns a Factory that loads client configuration using the
+// default kubeconfig loading rules.
+func NewFactory() *Factory {
+	return &Factory{
+		loadingRules: clientcmd.NewDefaultClientConfigLoadingRules(),
+		overrides:    &clientcmd.ConfigOverrides{},
+	}
+}
+
+// AddFlags registers the client configuration flags on the given flag set.
+func (f *Factory) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&f.loadingRules.ExplicitPath, clientcmd.RecommendedConfigPathFlag, "", ""+
+		"Path to the kubeconfig file to use for CLI requests.")
+	clientcmd.BindOverrideFlags(f.overrides, fs, clientcmd.RecommendedConfigOverrideFlags(""))
+}
+
+func (f *Factory) toClientConfig() clientcmd.ClientConfig {
+	if f.clientConfig == nil {
+		f.clientConfig = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(f.loadingRules, f.overrides)
+	}
+	return f.clientConfig
+}
+
+// Namespace returns the namespace that commands should operate in, taking
+// into account the --namespace flag and the current kubeconfig context.
+func (f *Factory) Namespace() (string, error) {
+	ns, _, err := f.toClientConfig().Namespace()
+	if err != nil {
+		return "", fmt.Errorf("error determining namespace: %v", err)
+	}
+	return ns, nil
+}
+
+// RESTConfig returns the REST client configuration for the target cluster.
+func (f *Factory) RESTConfig() (*rest.Config, error) {
+	if f.restConfig != nil {
+		return f.restConfig, nil
+	}
+	cfg, err := f.toClientConfig().ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error loading client configuration: %v", err)
+	}
+	f.restConfig = cfg
+	return cfg, nil
+}
+
+// KubeClient returns a Kubernetes clientset for the target cluster.
+func (f *Factory) KubeClient() (kubernetes.Interface, error) {
+	cfg, err := f.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(cfg)
+}
+
+// CMClient returns a cert-manager clientset for the target cluster.
+func (f *Factory) CMClient() (cmclient.Interface, error) {
+	cfg, err := f.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	return cmclient.NewForConfig(cfg)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// tlsCAKey is the key in a Secret that cert-manager stores the CA
+// certificate under.
+const tlsCAKey = "ca.crt"
+
+const inspectSecretLong = `
+Decode and print the x509 certificate chain stored in a Secret.
+
+The certificate chain is read from the tls.crt key of the Secret, and the CA
+certificate from the ca.crt key if present.`
+
+// NewCmdInspect returns a cobra command grouping the inspect subcommands.
+func NewCmdInspect(f *Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "Get detailed information about files created by cert-manager",
+	}
+
+	cmd.AddCommand(NewCmdInspectSecret(f, out))
+
+	return cmd
+}
+
+// InspectSecretOptions holds the options for the inspect secret command
+type InspectSecretOptions struct {
+	Namespace string
+
+	KubeClient kubernetes.Interface
+	Out        io.Writer
+
+	// used for testing
+	now func() time.Time
+}
+
+// NewCmdInspectSecret returns a cobra command that decodes the certificate
+// stored in a Secret.
+func NewCmdInspectSecret(f *Factory, out io.Writer) *cobra.Command {
+	o := &InspectSecretOptions{Out: out, now: time.Now}
+
+	cmd := &cobra.Command{
+		Use:   "secret NAME",
+		Short: "Decode the x509 certificate chain stored in a Secret",
+		Long:  inspectSecretLong,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("please specify exactly one Secret name")
+			}
+			if err := o.Complete(f); err != nil {
+				return err
+			}
+			return o.Run(args[0])
+		},
+	}
+
+	return cmd
+}
+
+// Complete builds the clients and namespace used by Run.
+func (o *InspectSecretOptions) Complete(f *Factory) error {
+	var err error
+	if o.Namespace, err = f.Namespace(); err != nil {
+		return err
+	}
+	if o.KubeClient, err = f.KubeClient(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Run prints the certificates contained in the named Secret.
+func (o *InspectSecretOptions) Run(name string) error {
+	secret, err := o.KubeClient.CoreV1().Secrets(o.Namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting Secret %s/%s: %v", o.Namespace, name, err)
+	}
+
+	certData := secret.Data[corev1.TLSCertKey]
+	if len(certData) == 0 {
+		return fmt.Errorf("Secret %s/%s does not contain a certificate in the %q key", o.Namespace, name, corev1.TLSCertKey)
+	}
+
+	chain, err := pki.DecodeX509CertificateChainBytes(certData)
+	if err != nil {
+		return fmt.Errorf("error decoding certificate in Secret %s/%s: %v", o.Namespace, name, err)
+	}
+
+	fmt.Fprintf(o.Out, "Certificate:\n")
+	describeX509Certificate(o.Out, chain[0], o.now(), "  ")
+
+	for i, cert := range chain[1:] {
+		fmt.Fprintf(o.Out, "\nChain certificate %d:\n", i+1)
+		describeX509Certificate(o.Out, cert, o.now(), "  ")
+	}
+
+	if caData := secret.Data[tlsCAKey]; len(caData) > 0 {
+		ca, err := pki.DecodeX509CertificateBytes(caData)
+		if err != nil {
+			return fmt.Errorf("error decoding CA certificate in Secret %s/%s: %v", o.Namespace, name, err)
+		}
+		fmt.Fprintf(o.Out, "\nCA certificate:\n")
+		describeX509Certificate(o.Out, ca, o.now(), "  ")
+	}
+
+	return nil
+}
+
+// describeX509Certificate writes a human readable summary of cert to w, with
+// each line prefixed by indent.
+func describeX509Certificate(w io.Writer, cert *x509.Certificate, now time.Time, indent string) {
+	fmt.Fprintf(w, "%sSubject:           %s\n", indent, cert.Subject.String())
+	fmt.Fprintf(w, "%sIssuer:            %s\n", indent, cert.Issuer.String())
+	if len(cert.DNSNames) > 0 {
+		fmt.Fprintf(w, "%sDNS Names:         %s\n", indent, strings.Join(cert.DNSNames, ", "))
+	}
+	if len(cert.IPAddresses) > 0 {
+		fmt.Fprintf(w, "%sIP Addresses:      %s\n", indent, strings.Join(pki.IPAddressesToString(cert.IPAddresses), ", "))
+	}
+	if len(cert.URIs) > 0 {
+		fmt.Fprintf(w, "%sURIs:              %s\n", indent, strings.Join(pki.URLsToString(cert.URIs), ", "))
+	}
+	if len(cert.EmailAddresses) > 0 {
+		fmt.Fprintf(w, "%sEmail Addresses:   %s\n", indent, strings.Join(cert.EmailAddresses, ", "))
+	}
+	fmt.Fprintf(w, "%sIs a CA:           %t\n", indent, cert.IsCA)
+	fmt.Fprintf(w, "%sSerial Number:     %s\n", indent, pki.SerialNumberString(cert))
+	fmt.Fprintf(w, "%sFingerprint:       %s\n", indent, pki.SHA256Fingerprint(cert))
+	fmt.Fprintf(w, "%sNot Before:        %s\n", indent, cert.NotBefore.UTC().Format(time.RFC3339))
+	fmt.Fprintf(w, "%sNot After:         %s\n", indent, cert.NotAfter.UTC().Format(time.RFC3339))
+	fmt.Fprintf(w, "%sValidity:          %s\n", indent, validityString(cert, now))
+}
+
+func validityString(cert *x509.Certificate, now time.Time) string {
+	switch {
+	case now.Before(cert.NotBefore):
+		return "not yet valid"
+	case now.After(cert.NotAfter):
+		return fmt.Sprintf("expired %s ago", now.Sub(cert.NotAfter).Round(time.Second))
+	default:
+		return fmt.Sprintf("valid for %s", cert.NotAfter.Sub(now).Round(time.Second))
+	}
+}
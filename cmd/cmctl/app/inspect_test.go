@@ -0,0 +1,135 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func generateSelfSignedCertPEM(t *testing.T, crt *cmapi.Certificate, notBefore, notAfter time.Time) []byte {
+	key, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatalf("error generating private key: %v", err)
+	}
+
+	template, err := pki.GenerateTemplate(crt)
+	if err != nil {
+		t.Fatalf("error generating template: %v", err)
+	}
+	template.SerialNumber = big.NewInt(0x0a1b2c)
+	template.NotBefore = notBefore
+	template.NotAfter = notAfter
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatalf("error signing cert: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes})
+}
+
+func TestInspectSecretRun(t *testing.T) {
+	now := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	certPEM := generateSelfSignedCertPEM(t,
+		gen.Certificate("test",
+			gen.SetCertificateCommonName("example.com"),
+			gen.SetCertificateDNSNames("example.com", "www.example.com"),
+		),
+		now.Add(-time.Hour), now.Add(time.Hour),
+	)
+
+	secret := func(name string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: gen.ObjectMeta(name), Data: data}
+	}
+
+	tests := map[string]struct {
+		objects   []runtime.Object
+		name      string
+		expOutput []string
+		wantErr   bool
+	}{
+		"print a certificate and its CA": {
+			objects: []runtime.Object{secret("tls", map[string][]byte{
+				corev1.TLSCertKey: certPEM,
+				tlsCAKey:          certPEM,
+			})},
+			name: "tls",
+			expOutput: []string{
+				"Certificate:",
+				"CN=example.com",
+				"example.com, www.example.com",
+				"0A1B2C",
+				"valid for 1h0m0s",
+				"CA certificate:",
+			},
+		},
+		"error if the secret does not exist": {
+			name:    "missing",
+			wantErr: true,
+		},
+		"error if the secret does not contain a certificate": {
+			objects: []runtime.Object{secret("tls", nil)},
+			name:    "tls",
+			wantErr: true,
+		},
+		"error if the certificate cannot be decoded": {
+			objects: []runtime.Object{secret("tls", map[string][]byte{
+				corev1.TLSCertKey: []byte("not a certificate"),
+			})},
+			name:    "tls",
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			o := &InspectSecretOptions{
+				Namespace:  gen.DefaultTestNamespace,
+				KubeClient: kubefake.NewSimpleClientset(test.objects...),
+				Out:        out,
+				now:        func() time.Time { return now },
+			}
+
+			err := o.Run(test.name)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error=%t, got: %v", test.wantErr, err)
+			}
+
+			for _, s := range test.expOutput {
+				if !strings.Contains(out.String(), s) {
+					t.Errorf("expected output to contain %q, got:\n%s", s, out.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+)
+
+const renewLong = `
+Mark one or more Certificates for manual re-issuance.
+
+This sets the Issuing condition of each Certificate to True, which causes
+cert-manager to issue a new certificate even if the current one is still valid.`
+
+// RenewOptions holds the options for the renew command
+type RenewOptions struct {
+	Namespace string
+	All       bool
+
+	CMClient cmclient.Interface
+	Out      io.Writer
+}
+
+// NewCmdRenew returns a cobra command for triggering re-issuance of
+// Certificates.
+func NewCmdRenew(f *Factory, out io.Writer) *cobra.Command {
+	o := &RenewOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:   "renew [--all | NAME...]",
+		Short: "Mark Certificates for manual re-issuance",
+		Long:  renewLong,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			if err := o.Complete(f); err != nil {
+				return err
+			}
+			return o.Run(args)
+		},
+	}
+
+	cmd.Flags().BoolVar(&o.All, "all", false, ""+
+		"Renew all Certificates in the given namespace")
+
+	return cmd
+}
+
+// Validate checks that either Certificate names or the --all flag are given.
+func (o *RenewOptions) Validate(args []string) error {
+	if o.All && len(args) > 0 {
+		return fmt.Errorf("cannot specify Certificate names in conjunction with the --all flag")
+	}
+	if !o.All && len(args) == 0 {
+		return fmt.Errorf("please specify at least one Certificate name, or use the --all flag")
+	}
+	return nil
+}
+
+// Complete builds the clients and namespace used by Run.
+func (o *RenewOptions) Complete(f *Factory) error {
+	var err error
+	if o.Namespace, err = f.Namespace(); err != nil {
+		return err
+	}
+	if o.CMClient, err = f.CMClient(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Run triggers re-issuance of the named Certificates, or of all Certificates
+// in the namespace if the --all flag is set.
+func (o *RenewOptions) Run(names []string) error {
+	cl := o.CMClient.CertmanagerV1alpha1().Certificates(o.Namespace)
+
+	var crts []cmapi.Certificate
+	if o.All {
+		list, err := cl.List(metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("error listing Certificates in namespace %q: %v", o.Namespace, err)
+		}
+		crts = list.Items
+	} else {
+		for _, name := range names {
+			crt, err := cl.Get(name, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("error getting Certificate %s/%s: %v", o.Namespace, name, err)
+			}
+			crts = append(crts, *crt)
+		}
+	}
+
+	for i := range crts {
+		if err := o.renewCertificate(&crts[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *RenewOptions) renewCertificate(crt *cmapi.Certificate) error {
+	if apiutil.CertificateIssuanceRequested(crt) {
+		fmt.Fprintf(o.Out, "Re-issuance of Certificate %s/%s has already been requested\n", crt.Namespace, crt.Name)
+		return nil
+	}
+
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, cmapi.CertificateConditionIssuing, cmapi.ConditionTrue,
+		cmapi.CertificateReasonManuallyTriggered, "Certificate re-issuance manually triggered")
+
+	if _, err := o.CMClient.CertmanagerV1alpha1().Certificates(crt.Namespace).Update(crt); err != nil {
+		return fmt.Errorf("error triggering re-issuance of Certificate %s/%s: %v", crt.Namespace, crt.Name, err)
+	}
+
+	fmt.Fprintf(o.Out, "Manually triggered issuance of Certificate %s/%s\n", crt.Namespace, crt.Name)
+
+	return nil
+}
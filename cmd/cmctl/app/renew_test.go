@@ -0,0 +1,149 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestRenewValidate(t *testing.T) {
+	tests := map[string]struct {
+		all     bool
+		args    []string
+		wantErr bool
+	}{
+		"names given": {
+			args: []string{"a", "b"},
+		},
+		"--all given": {
+			all: true,
+		},
+		"neither names or --all given": {
+			wantErr: true,
+		},
+		"both names and --all given": {
+			all:     true,
+			args:    []string{"a"},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			o := &RenewOptions{All: test.all}
+			err := o.Validate(test.args)
+			if (err != nil) != test.wantErr {
+				t.Errorf("expected error=%t, got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRenewRun(t *testing.T) {
+	crtA := gen.Certificate("a")
+	crtB := gen.Certificate("b")
+	crtRequested := gen.Certificate("requested",
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:   cmapi.CertificateConditionIssuing,
+			Status: cmapi.ConditionTrue,
+			Reason: cmapi.CertificateReasonManuallyTriggered,
+		}),
+	)
+
+	tests := map[string]struct {
+		all          bool
+		args         []string
+		objects      []runtime.Object
+		expRenewed   []string
+		expUnchanged []string
+		wantErr      bool
+	}{
+		"renew a single named certificate": {
+			args:         []string{"a"},
+			objects:      []runtime.Object{crtA, crtB},
+			expRenewed:   []string{"a"},
+			expUnchanged: []string{"b"},
+		},
+		"renew all certificates in the namespace": {
+			all:        true,
+			objects:    []runtime.Object{crtA, crtB},
+			expRenewed: []string{"a", "b"},
+		},
+		"do not update a certificate that already has re-issuance requested": {
+			args:         []string{"requested"},
+			objects:      []runtime.Object{crtRequested},
+			expUnchanged: []string{"requested"},
+		},
+		"error if a named certificate does not exist": {
+			args:    []string{"missing"},
+			objects: []runtime.Object{crtA},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := cmfake.NewSimpleClientset(test.objects...)
+			o := &RenewOptions{
+				Namespace: gen.DefaultTestNamespace,
+				All:       test.all,
+				CMClient:  cl,
+				Out:       &bytes.Buffer{},
+			}
+
+			err := o.Run(test.args)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error=%t, got: %v", test.wantErr, err)
+			}
+
+			for _, n := range test.expRenewed {
+				crt, err := cl.CertmanagerV1alpha1().Certificates(gen.DefaultTestNamespace).Get(n, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !apiutil.CertificateIssuanceRequested(crt) {
+					t.Errorf("expected re-issuance of Certificate %q to be requested", n)
+				}
+				if c := crt.Status.Conditions[0]; c.Reason != cmapi.CertificateReasonManuallyTriggered {
+					t.Errorf("expected Issuing condition reason %q, got %q", cmapi.CertificateReasonManuallyTriggered, c.Reason)
+				}
+			}
+
+			for _, a := range cl.Actions() {
+				update, ok := a.(coretesting.UpdateAction)
+				if !ok {
+					continue
+				}
+				for _, n := range test.expUnchanged {
+					if update.GetObject().(*cmapi.Certificate).Name == n {
+						t.Errorf("unexpected update to Certificate %q", n)
+					}
+				}
+			}
+		})
+	}
+}
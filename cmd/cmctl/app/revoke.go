@@ -0,0 +1,157 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+)
+
+const revokeLong = `
+Revoke the currently issued certificate of one or more Certificates.
+
+The serial number of each Certificate's current certificate is recorded in the
+status of the CA Issuer or ClusterIssuer that signed it, and will be included
+in the next certificate revocation list published for that issuer. Only
+Certificates issued by a CA issuer with a 'crl' configuration can be revoked.`
+
+// RevokeOptions holds the options for the revoke command
+type RevokeOptions struct {
+	Namespace string
+
+	CMClient cmclient.Interface
+	Out      io.Writer
+}
+
+// NewCmdRevoke returns a cobra command for revoking the certificates issued
+// for Certificates.
+func NewCmdRevoke(f *Factory, out io.Writer) *cobra.Command {
+	o := &RevokeOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:   "revoke NAME...",
+		Short: "Revoke the certificates issued for Certificates",
+		Long:  revokeLong,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			if err := o.Complete(f); err != nil {
+				return err
+			}
+			return o.Run(args)
+		},
+	}
+
+	return cmd
+}
+
+// Validate checks that at least one Certificate name is given.
+func (o *RevokeOptions) Validate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("please specify at least one Certificate name")
+	}
+	return nil
+}
+
+// Complete builds the clients and namespace used by Run.
+func (o *RevokeOptions) Complete(f *Factory) error {
+	var err error
+	if o.Namespace, err = f.Namespace(); err != nil {
+		return err
+	}
+	if o.CMClient, err = f.CMClient(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Run revokes the current certificate of each of the named Certificates.
+func (o *RevokeOptions) Run(names []string) error {
+	for _, name := range names {
+		crt, err := o.CMClient.CertmanagerV1alpha1().Certificates(o.Namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error getting Certificate %s/%s: %v", o.Namespace, name, err)
+		}
+		if err := o.revokeCertificate(crt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *RevokeOptions) revokeCertificate(crt *cmapi.Certificate) error {
+	if crt.Status.SerialNumber == "" {
+		return fmt.Errorf("Certificate %s/%s does not have an issued certificate to revoke", crt.Namespace, crt.Name)
+	}
+
+	iss, err := o.getIssuer(crt)
+	if err != nil {
+		return err
+	}
+
+	if iss.GetSpec().CA == nil || iss.GetSpec().CA.CRL == nil {
+		return fmt.Errorf("%s %q referenced by Certificate %s/%s is not a CA issuer with a certificate revocation list configured",
+			crt.Spec.IssuerRef.Kind, iss.GetObjectMeta().Name, crt.Namespace, crt.Name)
+	}
+
+	if !apiutil.RevokeCertificate(iss, crt.Status.SerialNumber, metav1.Now(), crt.Status.NotAfter) {
+		fmt.Fprintf(o.Out, "Certificate %s/%s with serial number %s has already been revoked\n", crt.Namespace, crt.Name, crt.Status.SerialNumber)
+		return nil
+	}
+
+	switch iss := iss.(type) {
+	case *cmapi.Issuer:
+		_, err = o.CMClient.CertmanagerV1alpha1().Issuers(iss.Namespace).Update(iss)
+	case *cmapi.ClusterIssuer:
+		_, err = o.CMClient.CertmanagerV1alpha1().ClusterIssuers().Update(iss)
+	}
+	if err != nil {
+		return fmt.Errorf("error revoking Certificate %s/%s: %v", crt.Namespace, crt.Name, err)
+	}
+
+	fmt.Fprintf(o.Out, "Revoked Certificate %s/%s with serial number %s\n", crt.Namespace, crt.Name, crt.Status.SerialNumber)
+
+	return nil
+}
+
+func (o *RevokeOptions) getIssuer(crt *cmapi.Certificate) (cmapi.GenericIssuer, error) {
+	ref := crt.Spec.IssuerRef
+	switch ref.Kind {
+	case "", cmapi.IssuerKind:
+		iss, err := o.CMClient.CertmanagerV1alpha1().Issuers(crt.Namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting Issuer %s/%s: %v", crt.Namespace, ref.Name, err)
+		}
+		return iss, nil
+	case cmapi.ClusterIssuerKind:
+		iss, err := o.CMClient.CertmanagerV1alpha1().ClusterIssuers().Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting ClusterIssuer %s: %v", ref.Name, err)
+		}
+		return iss, nil
+	default:
+		return nil, fmt.Errorf("Certificate %s/%s references unsupported issuer kind %q", crt.Namespace, crt.Name, ref.Kind)
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestRevokeRun(t *testing.T) {
+	caWithCRL := gen.SetIssuerCA(cmapi.CAIssuer{
+		SecretName: "ca",
+		CRL:        &cmapi.CACRLConfig{SecretName: "ca-crl"},
+	})
+	issuer := gen.Issuer("ca", caWithCRL)
+	clusterIssuer := gen.ClusterIssuer("ca", caWithCRL)
+	noCRLIssuer := gen.Issuer("no-crl", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca"}))
+	revokedIssuer := gen.IssuerFrom(gen.Issuer("ca", caWithCRL), func(iss cmapi.GenericIssuer) {
+		iss.GetStatus().CA = &cmapi.CAIssuerStatus{
+			RevokedCertificates: []cmapi.RevokedCertificate{{SerialNumber: "0A"}},
+		}
+	})
+
+	crt := gen.Certificate("a",
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "ca"}),
+		gen.SetCertificateSerialNumber("0A"),
+	)
+	clusterCrt := gen.Certificate("a",
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "ca", Kind: cmapi.ClusterIssuerKind}),
+		gen.SetCertificateSerialNumber("0A"),
+	)
+	noCRLCrt := gen.Certificate("a",
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "no-crl"}),
+		gen.SetCertificateSerialNumber("0A"),
+	)
+	notIssuedCrt := gen.Certificate("a",
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "ca"}),
+	)
+
+	tests := map[string]struct {
+		objects []runtime.Object
+		// expRevoked is the list of serial numbers expected to be recorded
+		// as revoked in the status of the issuer or cluster issuer named
+		// 'ca'
+		expRevoked []string
+		wantErr    bool
+	}{
+		"revoke a certificate issued by an Issuer": {
+			objects:    []runtime.Object{crt, issuer},
+			expRevoked: []string{"0A"},
+		},
+		"revoke a certificate issued by a ClusterIssuer": {
+			objects:    []runtime.Object{clusterCrt, clusterIssuer},
+			expRevoked: []string{"0A"},
+		},
+		"do nothing if the certificate has already been revoked": {
+			objects:    []runtime.Object{crt, revokedIssuer},
+			expRevoked: []string{"0A"},
+		},
+		"error if the issuer does not have a CRL configured": {
+			objects: []runtime.Object{noCRLCrt, noCRLIssuer},
+			wantErr: true,
+		},
+		"error if the certificate has not been issued": {
+			objects: []runtime.Object{notIssuedCrt, issuer},
+			wantErr: true,
+		},
+		"error if the issuer does not exist": {
+			objects: []runtime.Object{crt},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := cmfake.NewSimpleClientset(test.objects...)
+			o := &RevokeOptions{
+				Namespace: gen.DefaultTestNamespace,
+				CMClient:  cl,
+				Out:       &bytes.Buffer{},
+			}
+
+			err := o.Run([]string{"a"})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error=%t, got: %v", test.wantErr, err)
+			}
+			if test.wantErr {
+				return
+			}
+
+			var iss cmapi.GenericIssuer
+			iss, err = cl.CertmanagerV1alpha1().Issuers(gen.DefaultTestNamespace).Get("ca", metav1.GetOptions{})
+			if err != nil {
+				iss, err = cl.CertmanagerV1alpha1().ClusterIssuers().Get("ca", metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var revoked []string
+			if status := iss.GetStatus().CA; status != nil {
+				for _, r := range status.RevokedCertificates {
+					revoked = append(revoked, r.SerialNumber)
+				}
+			}
+			if len(revoked) != len(test.expRevoked) {
+				t.Fatalf("expected revoked serial numbers %v, got %v", test.expRevoked, revoked)
+			}
+			for i := range revoked {
+				if revoked[i] != test.expRevoked[i] {
+					t.Errorf("expected revoked serial numbers %v, got %v", test.expRevoked, revoked)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// maxStatusEvents is the maximum number of events shown for a Certificate.
+const maxStatusEvents = 10
+
+const statusCertificateLong = `
+Show the status of a Certificate along with the resources related to it.
+
+This includes the Issuer or ClusterIssuer it references, the Secret the
+certificate is stored in, any CertificateRequests, Orders and Challenges
+created for it, and the most recent events recorded against the Certificate.`
+
+// NewCmdStatus returns a cobra command grouping the status subcommands.
+func NewCmdStatus(f *Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Get details on the current status of cert-manager resources",
+	}
+
+	cmd.AddCommand(NewCmdStatusCertificate(f, out))
+
+	return cmd
+}
+
+// StatusCertificateOptions holds the options for the status certificate
+// command
+type StatusCertificateOptions struct {
+	Namespace string
+
+	KubeClient kubernetes.Interface
+	CMClient   cmclient.Interface
+	Out        io.Writer
+
+	// used for testing
+	now func() time.Time
+}
+
+// NewCmdStatusCertificate returns a cobra command that prints the status of a
+// Certificate and its related resources.
+func NewCmdStatusCertificate(f *Factory, out io.Writer) *cobra.Command {
+	o := &StatusCertificateOptions{Out: out, now: time.Now}
+
+	cmd := &cobra.Command{
+		Use:   "certificate NAME",
+		Short: "Get details on the current status of a Certificate",
+		Long:  statusCertificateLong,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("please specify exactly one Certificate name")
+			}
+			if err := o.Complete(f); err != nil {
+				return err
+			}
+			return o.Run(args[0])
+		},
+	}
+
+	return cmd
+}
+
+// Complete builds the clients and namespace used by Run.
+func (o *StatusCertificateOptions) Complete(f *Factory) error {
+	var err error
+	if o.Namespace, err = f.Namespace(); err != nil {
+		return err
+	}
+	if o.KubeClient, err = f.KubeClient(); err != nil {
+		return err
+	}
+	if o.CMClient, err = f.CMClient(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Run prints the status of the named Certificate. Errors retrieving related
+// resources are printed inline rather than aborting, so that as much
+// information as possible is shown for a Certificate that is not healthy.
+func (o *StatusCertificateOptions) Run(name string) error {
+	crt, err := o.CMClient.CertmanagerV1alpha1().Certificates(o.Namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting Certificate %s/%s: %v", o.Namespace, name, err)
+	}
+
+	o.describeCertificate(crt)
+	o.describeIssuer(crt)
+	o.describeSecret(crt)
+	o.describeCertificateRequests(crt)
+	o.describeOrders(crt)
+	o.describeEvents(crt)
+
+	return nil
+}
+
+func (o *StatusCertificateOptions) describeCertificate(crt *cmapi.Certificate) {
+	w := o.Out
+	fmt.Fprintf(w, "Name:          %s\n", crt.Name)
+	fmt.Fprintf(w, "Namespace:     %s\n", crt.Namespace)
+	fmt.Fprintf(w, "Created at:    %s\n", formatTime(&crt.CreationTimestamp))
+
+	fmt.Fprintf(w, "Conditions:\n")
+	if len(crt.Status.Conditions) == 0 {
+		fmt.Fprintf(w, "  No conditions set\n")
+	}
+	for _, c := range crt.Status.Conditions {
+		fmt.Fprintf(w, "  %s: %s, Reason: %s, Message: %s\n", c.Type, c.Status, c.Reason, c.Message)
+	}
+
+	if names := pki.DNSNamesForCertificate(crt); len(names) > 0 {
+		fmt.Fprintf(w, "DNS Names:     %s\n", strings.Join(names, ", "))
+	}
+	if crt.Status.NotBefore != nil {
+		fmt.Fprintf(w, "Not Before:    %s\n", formatTime(crt.Status.NotBefore))
+	}
+	if crt.Status.NotAfter != nil {
+		fmt.Fprintf(w, "Not After:     %s\n", formatTime(crt.Status.NotAfter))
+	}
+	if crt.Status.RenewalTime != nil {
+		fmt.Fprintf(w, "Renewal Time:  %s\n", formatTime(crt.Status.RenewalTime))
+	}
+	if crt.Status.SerialNumber != "" {
+		fmt.Fprintf(w, "Serial Number: %s\n", crt.Status.SerialNumber)
+	}
+	if crt.Status.Fingerprint != "" {
+		fmt.Fprintf(w, "Fingerprint:   %s\n", crt.Status.Fingerprint)
+	}
+	if crt.Status.Revision != nil {
+		fmt.Fprintf(w, "Revision:      %d\n", *crt.Status.Revision)
+	}
+}
+
+func (o *StatusCertificateOptions) describeIssuer(crt *cmapi.Certificate) {
+	w := o.Out
+	ref := crt.Spec.IssuerRef
+	kind := ref.Kind
+	if kind == "" {
+		kind = cmapi.IssuerKind
+	}
+
+	var issuer cmapi.GenericIssuer
+	var err error
+	switch kind {
+	case cmapi.IssuerKind:
+		issuer, err = o.CMClient.CertmanagerV1alpha1().Issuers(crt.Namespace).Get(ref.Name, metav1.GetOptions{})
+	case cmapi.ClusterIssuerKind:
+		issuer, err = o.CMClient.CertmanagerV1alpha1().ClusterIssuers().Get(ref.Name, metav1.GetOptions{})
+	default:
+		fmt.Fprintf(w, "Issuer:\n  %s %q is handled by an external signer\n", kind, ref.Name)
+		return
+	}
+
+	fmt.Fprintf(w, "Issuer:\n")
+	if err != nil {
+		fmt.Fprintf(w, "  Error getting %s %q: %v\n", kind, ref.Name, err)
+		return
+	}
+
+	fmt.Fprintf(w, "  Name:  %s\n", ref.Name)
+	fmt.Fprintf(w, "  Kind:  %s\n", kind)
+	if typ, err := apiutil.NameForIssuer(issuer); err == nil {
+		fmt.Fprintf(w, "  Type:  %s\n", typ)
+	}
+	for _, c := range issuer.GetStatus().Conditions {
+		fmt.Fprintf(w, "  %s: %s, Reason: %s, Message: %s\n", c.Type, c.Status, c.Reason, c.Message)
+	}
+}
+
+func (o *StatusCertificateOptions) describeSecret(crt *cmapi.Certificate) {
+	w := o.Out
+	fmt.Fprintf(w, "Secret:\n")
+
+	secret, err := o.KubeClient.CoreV1().Secrets(crt.Namespace).Get(crt.Spec.SecretName, metav1.GetOptions{})
+	if k8sErrors.IsNotFound(err) {
+		fmt.Fprintf(w, "  Secret %q does not exist\n", crt.Spec.SecretName)
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(w, "  Error getting Secret %q: %v\n", crt.Spec.SecretName, err)
+		return
+	}
+
+	fmt.Fprintf(w, "  Name:  %s\n", secret.Name)
+	certData := secret.Data[corev1.TLSCertKey]
+	if len(certData) == 0 {
+		fmt.Fprintf(w, "  Secret does not contain a certificate\n")
+		return
+	}
+
+	chain, err := pki.DecodeX509CertificateChainBytes(certData)
+	if err != nil {
+		fmt.Fprintf(w, "  Error decoding certificate: %v\n", err)
+		return
+	}
+	describeX509Certificate(w, chain[0], o.now(), "  ")
+}
+
+func (o *StatusCertificateOptions) describeCertificateRequests(crt *cmapi.Certificate) {
+	w := o.Out
+	list, err := o.CMClient.CertmanagerV1alpha1().CertificateRequests(crt.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		fmt.Fprintf(w, "CertificateRequests:\n  Error listing CertificateRequests: %v\n", err)
+		return
+	}
+
+	var crs []cmapi.CertificateRequest
+	for _, cr := range list.Items {
+		if metav1.IsControlledBy(&cr, crt) {
+			crs = append(crs, cr)
+		}
+	}
+	if len(crs) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "CertificateRequests:\n")
+	for _, cr := range crs {
+		fmt.Fprintf(w, "  Name:  %s\n", cr.Name)
+		for _, c := range cr.Status.Conditions {
+			fmt.Fprintf(w, "    %s: %s, Reason: %s, Message: %s\n", c.Type, c.Status, c.Reason, c.Message)
+		}
+	}
+}
+
+func (o *StatusCertificateOptions) describeOrders(crt *cmapi.Certificate) {
+	w := o.Out
+	list, err := o.CMClient.CertmanagerV1alpha1().Orders(crt.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		fmt.Fprintf(w, "Orders:\n  Error listing Orders: %v\n", err)
+		return
+	}
+
+	var orders []cmapi.Order
+	for _, order := range list.Items {
+		if metav1.IsControlledBy(&order, crt) {
+			orders = append(orders, order)
+		}
+	}
+	if len(orders) == 0 {
+		return
+	}
+
+	challenges, err := o.CMClient.CertmanagerV1alpha1().Challenges(crt.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		fmt.Fprintf(w, "Orders:\n  Error listing Challenges: %v\n", err)
+		return
+	}
+
+	fmt.Fprintf(w, "Orders:\n")
+	for _, order := range orders {
+		fmt.Fprintf(w, "  Name:    %s\n", order.Name)
+		fmt.Fprintf(w, "  State:   %s, Reason: %s\n", order.Status.State, order.Status.Reason)
+		if order.Status.URL != "" {
+			fmt.Fprintf(w, "  URL:     %s\n", order.Status.URL)
+		}
+		for _, ch := range challenges.Items {
+			if !metav1.IsControlledBy(&ch, &order) {
+				continue
+			}
+			fmt.Fprintf(w, "  Challenge %s:\n", ch.Name)
+			fmt.Fprintf(w, "    Type:       %s\n", ch.Spec.Type)
+			fmt.Fprintf(w, "    DNS Name:   %s\n", ch.Spec.DNSName)
+			fmt.Fprintf(w, "    State:      %s, Reason: %s, Processing: %t, Presented: %t\n",
+				ch.Status.State, ch.Status.Reason, ch.Status.Processing, ch.Status.Presented)
+		}
+	}
+}
+
+func (o *StatusCertificateOptions) describeEvents(crt *cmapi.Certificate) {
+	w := o.Out
+	list, err := o.KubeClient.CoreV1().Events(crt.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		fmt.Fprintf(w, "Events:\n  Error listing events: %v\n", err)
+		return
+	}
+
+	var events []corev1.Event
+	for _, e := range list.Items {
+		if e.InvolvedObject.Kind != cmapi.CertificateKind || e.InvolvedObject.Name != crt.Name {
+			continue
+		}
+		if e.InvolvedObject.UID != "" && crt.UID != "" && e.InvolvedObject.UID != crt.UID {
+			continue
+		}
+		events = append(events, e)
+	}
+
+	fmt.Fprintf(w, "Events:\n")
+	if len(events) == 0 {
+		fmt.Fprintf(w, "  No events found\n")
+		return
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(&events[j].LastTimestamp)
+	})
+	if len(events) > maxStatusEvents {
+		events = events[len(events)-maxStatusEvents:]
+	}
+	for _, e := range events {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", e.Type, e.Reason, formatTime(&e.LastTimestamp), e.Message)
+	}
+}
+
+func formatTime(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return "<unknown>"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
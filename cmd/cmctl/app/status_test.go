@@ -0,0 +1,176 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestStatusCertificateRun(t *testing.T) {
+	now := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	crt := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateSecretName("test-tls"),
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "letsencrypt", Kind: cmapi.IssuerKind}),
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:    cmapi.CertificateConditionReady,
+			Status:  cmapi.ConditionFalse,
+			Reason:  "InProgress",
+			Message: "Waiting for order to complete",
+		}),
+	)
+	crt.UID = "crt-uid"
+
+	issuer := gen.Issuer("letsencrypt",
+		gen.SetIssuerACME(cmapi.ACMEIssuer{}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmapi.ConditionTrue,
+		}),
+	)
+
+	secret := &corev1.Secret{
+		ObjectMeta: gen.ObjectMeta("test-tls"),
+		Data: map[string][]byte{
+			corev1.TLSCertKey: generateSelfSignedCertPEM(t, crt, now.Add(-time.Hour), now.Add(time.Hour)),
+		},
+	}
+
+	order := &cmapi.Order{
+		ObjectMeta: gen.ObjectMeta("test-order"),
+		Status: cmapi.OrderStatus{
+			State: cmapi.Pending,
+			URL:   "https://acme.example.com/order/1",
+		},
+	}
+	order.UID = "order-uid"
+	order.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(crt, cmapi.SchemeGroupVersion.WithKind(cmapi.CertificateKind))}
+
+	unownedOrder := &cmapi.Order{ObjectMeta: gen.ObjectMeta("other-order")}
+
+	challenge := gen.Challenge("test-challenge",
+		gen.SetChallengeType("http-01"),
+		gen.SetChallengeDNSName("example.com"),
+		gen.SetChallengeState(cmapi.Pending),
+		gen.SetChallengeReason("Waiting for HTTP-01 challenge propagation"),
+	)
+	challenge.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(order, cmapi.SchemeGroupVersion.WithKind(cmapi.OrderKind))}
+
+	event := &corev1.Event{
+		ObjectMeta: gen.ObjectMeta("test-event"),
+		InvolvedObject: corev1.ObjectReference{
+			Kind: cmapi.CertificateKind,
+			Name: crt.Name,
+			UID:  crt.UID,
+		},
+		Type:          corev1.EventTypeNormal,
+		Reason:        "OrderCreated",
+		Message:       "Created Order resource default-unit-test-ns/test-order",
+		LastTimestamp: metav1.NewTime(now),
+	}
+	otherEvent := &corev1.Event{
+		ObjectMeta: gen.ObjectMeta("other-event"),
+		InvolvedObject: corev1.ObjectReference{
+			Kind: cmapi.CertificateKind,
+			Name: "other",
+		},
+		Reason: "OtherReason",
+	}
+
+	tests := map[string]struct {
+		kubeObjects []runtime.Object
+		cmObjects   []runtime.Object
+		expOutput   []string
+		unexpOutput []string
+		wantErr     bool
+	}{
+		"show a certificate with all of its related resources": {
+			kubeObjects: []runtime.Object{secret, event, otherEvent},
+			cmObjects:   []runtime.Object{crt, issuer, order, unownedOrder, challenge},
+			expOutput: []string{
+				"Name:          test",
+				"Ready: False, Reason: InProgress, Message: Waiting for order to complete",
+				"DNS Names:     example.com",
+				"Type:  acme",
+				"Fingerprint:",
+				"Name:    test-order",
+				"https://acme.example.com/order/1",
+				"Challenge test-challenge:",
+				"Waiting for HTTP-01 challenge propagation",
+				"OrderCreated",
+			},
+			unexpOutput: []string{
+				"other-order",
+				"OtherReason",
+			},
+		},
+		"show a certificate whose issuer and secret do not exist": {
+			cmObjects: []runtime.Object{crt},
+			expOutput: []string{
+				"Name:          test",
+				`Error getting Issuer "letsencrypt"`,
+				`Secret "test-tls" does not exist`,
+				"No events found",
+			},
+		},
+		"error if the certificate does not exist": {
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			o := &StatusCertificateOptions{
+				Namespace:  gen.DefaultTestNamespace,
+				KubeClient: kubefake.NewSimpleClientset(test.kubeObjects...),
+				CMClient:   cmfake.NewSimpleClientset(test.cmObjects...),
+				Out:        out,
+				now:        func() time.Time { return now },
+			}
+
+			err := o.Run(crt.Name)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error=%t, got: %v", test.wantErr, err)
+			}
+
+			for _, s := range test.expOutput {
+				if !strings.Contains(out.String(), s) {
+					t.Errorf("expected output to contain %q, got:\n%s", s, out.String())
+				}
+			}
+			for _, s := range test.unexpOutput {
+				if strings.Contains(out.String(), s) {
+					t.Errorf("expected output not to contain %q, got:\n%s", s, out.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"os"
+
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"github.com/jetstack/cert-manager/cmd/cmctl/app"
+	"github.com/jetstack/cert-manager/pkg/logs"
+)
+
+func main() {
+	logs.InitLogs(flag.CommandLine)
+	defer logs.FlushLogs()
+
+	cmd := app.NewCommandCertManagerCtl(os.Stdin, os.Stdout, os.Stderr)
+	cmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
+
+	flag.CommandLine.Parse([]string{})
+	if err := cmd.Execute(); err != nil {
+		logs.FlushLogs()
+		os.Exit(1)
+	}
+}
@@ -39,8 +39,10 @@ import (
 	clientset "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	intscheme "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/scheme"
 	informers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1alpha1"
 	"github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/clusterissuers"
+	"github.com/jetstack/cert-manager/pkg/crlserver"
 	dnsutil "github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/metrics"
@@ -71,6 +73,20 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) {
 		metrics.Default.Start(stopCh)
 	}()
 
+	if opts.CRLServerAddress != "" {
+		crlServer := buildCRLServer(rootCtx, ctx, opts)
+		// start the informers used by the CRL server so that every replica
+		// serves revocation lists, not only the elected leader
+		ctx.SharedInformerFactory.Start(stopCh)
+		ctx.KubeSharedInformerFactory.Start(stopCh)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			crlServer.Start(stopCh)
+		}()
+	}
+
 	run := func(_ context.Context) {
 		for n, fn := range controller.Known() {
 			log := log.WithValues("controller", n)
@@ -131,6 +147,23 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) {
 	panic("unreachable")
 }
 
+func buildCRLServer(rootCtx context.Context, ctx *controller.Context, opts *options.ControllerOptions) *crlserver.Server {
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	// ClusterIssuers are disabled if scoped to a single namespace
+	if ctx.Namespace == "" {
+		clusterIssuerLister = ctx.SharedInformerFactory.Certmanager().V1alpha1().ClusterIssuers().Lister()
+	}
+
+	return crlserver.New(
+		logf.NewContext(rootCtx, nil, "crl-server"),
+		opts.CRLServerAddress,
+		ctx.SharedInformerFactory.Certmanager().V1alpha1().Issuers().Lister(),
+		clusterIssuerLister,
+		ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+		opts.ClusterResourceNamespace,
+	)
+}
+
 func buildControllerContext(ctx context.Context, stopCh <-chan struct{}, opts *options.ControllerOptions) (*controller.Context, *rest.Config, error) {
 	log := logf.FromContext(ctx, "build-context")
 	// Load the users Kubernetes config
@@ -213,6 +246,8 @@ func buildControllerContext(ctx context.Context, stopCh <-chan struct{}, opts *o
 			IssuerAmbientCredentials:        opts.IssuerAmbientCredentials,
 			ClusterResourceNamespace:        opts.ClusterResourceNamespace,
 			RenewBeforeExpiryDuration:       opts.RenewBeforeExpiryDuration,
+			RenewBeforeExpiryPercentage:     opts.RenewBeforeExpiryPercentage,
+			RenewalJitterPercentage:         opts.RenewalJitterPercentage,
 		},
 		IngressShimOptions: controller.IngressShimOptions{
 			DefaultIssuerName:                  opts.DefaultIssuerName,
@@ -24,9 +24,11 @@ import (
 
 	"github.com/spf13/pflag"
 
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
 	challengescontroller "github.com/jetstack/cert-manager/pkg/controller/acmechallenges"
 	orderscontroller "github.com/jetstack/cert-manager/pkg/controller/acmeorders"
+	certificaterequestscontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests"
 	certificatescontroller "github.com/jetstack/cert-manager/pkg/controller/certificates"
 	clusterissuerscontroller "github.com/jetstack/cert-manager/pkg/controller/clusterissuers"
 	ingressshimcontroller "github.com/jetstack/cert-manager/pkg/controller/ingress-shim"
@@ -56,6 +58,8 @@ type ControllerOptions struct {
 	ClusterIssuerAmbientCredentials bool
 	IssuerAmbientCredentials        bool
 	RenewBeforeExpiryDuration       time.Duration
+	RenewBeforeExpiryPercentage     int32
+	RenewalJitterPercentage         int32
 
 	// Default issuer/certificates details consumed by ingress-shim
 	DefaultIssuerName                  string
@@ -73,6 +77,10 @@ type ControllerOptions struct {
 	EnableCertificateOwnerRef bool
 
 	MaxConcurrentChallenges int
+
+	// CRLServerAddress is the address the certificate revocation lists of CA
+	// issuers are served on. If empty, they will not be served.
+	CRLServerAddress string
 }
 
 const (
@@ -89,6 +97,8 @@ const (
 	defaultClusterIssuerAmbientCredentials = true
 	defaultIssuerAmbientCredentials        = false
 	defaultRenewBeforeExpiryDuration       = cmapi.DefaultRenewBefore
+	defaultRenewBeforeExpiryPercentage     = 0
+	defaultRenewalJitterPercentage         = 0
 
 	defaultTLSACMEIssuerName           = ""
 	defaultTLSACMEIssuerKind           = "Issuer"
@@ -99,6 +109,8 @@ const (
 	defaultDNS01RecursiveNameserversOnly = false
 
 	defaultMaxConcurrentChallenges = 60
+
+	defaultCRLServerAddress = ""
 )
 
 func computeACMEHTTP01SolverImage(arch string) string {
@@ -125,6 +137,11 @@ var (
 		ingressshimcontroller.ControllerName,
 		orderscontroller.ControllerName,
 		challengescontroller.ControllerName,
+		certificaterequestscontroller.ControllerName(apiutil.IssuerACME),
+		certificaterequestscontroller.ControllerName(apiutil.IssuerCA),
+		certificaterequestscontroller.ControllerName(apiutil.IssuerSelfSigned),
+		certificaterequestscontroller.ControllerName(apiutil.IssuerVault),
+		certificaterequestscontroller.ControllerName(apiutil.IssuerVenafi),
 	}
 )
 
@@ -142,6 +159,8 @@ func NewControllerOptions() *ControllerOptions {
 		ClusterIssuerAmbientCredentials:    defaultClusterIssuerAmbientCredentials,
 		IssuerAmbientCredentials:           defaultIssuerAmbientCredentials,
 		RenewBeforeExpiryDuration:          defaultRenewBeforeExpiryDuration,
+		RenewBeforeExpiryPercentage:        defaultRenewBeforeExpiryPercentage,
+		RenewalJitterPercentage:            defaultRenewalJitterPercentage,
 		DefaultIssuerName:                  defaultTLSACMEIssuerName,
 		DefaultIssuerKind:                  defaultTLSACMEIssuerKind,
 		DefaultAutoCertificateAnnotations:  defaultAutoCertificateAnnotations,
@@ -150,6 +169,7 @@ func NewControllerOptions() *ControllerOptions {
 		DNS01RecursiveNameservers:          []string{},
 		DNS01RecursiveNameserversOnly:      defaultDNS01RecursiveNameserversOnly,
 		EnableCertificateOwnerRef:          defaultEnableCertificateOwnerRef,
+		CRLServerAddress:                   defaultCRLServerAddress,
 	}
 }
 
@@ -213,6 +233,14 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 		"The default 'renew before expiry' time for Certificates. "+
 		"Once a certificate is within this duration until expiry, a new Certificate "+
 		"will be attempted to be issued.")
+	fs.Int32Var(&s.RenewBeforeExpiryPercentage, "renew-before-expiry-percentage", defaultRenewBeforeExpiryPercentage, ""+
+		"The default 'renew before expiry' time for Certificates, as a percentage of "+
+		"each certificate's total duration. If set, this takes precedence over "+
+		"--renew-before-expiry-duration. Must be between 0 and 99.")
+	fs.Int32Var(&s.RenewalJitterPercentage, "renewal-jitter-percentage", defaultRenewalJitterPercentage, ""+
+		"The maximum amount of time, as a percentage of each certificate's total duration, "+
+		"by which the renewal of a Certificate will be brought forward. This spreads out "+
+		"the renewal of certificates that were issued at the same time. Must be between 0 and 99.")
 	fs.StringSliceVar(&s.DefaultAutoCertificateAnnotations, "auto-certificate-annotations", defaultAutoCertificateAnnotations, ""+
 		"The annotation consumed by the ingress-shim controller to indicate a ingress is requesting a certificate")
 
@@ -246,6 +274,11 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 		"When this flag is enabled, the secret will be automatically removed when the certificate resource is deleted.")
 	fs.IntVar(&s.MaxConcurrentChallenges, "max-concurrent-challenges", defaultMaxConcurrentChallenges, ""+
 		"The maximum number of challenges that can be scheduled as 'processing' at once.")
+	fs.StringVar(&s.CRLServerAddress, "crl-server-address", defaultCRLServerAddress, ""+
+		"The address to serve the certificate revocation lists of CA issuers on, for "+
+		"example 0.0.0.0:8080. Lists are served at /crl/<namespace>/<issuer name> for "+
+		"Issuers and /crl/<clusterissuer name> for ClusterIssuers. If not specified, "+
+		"certificate revocation lists will not be served.")
 }
 
 func (o *ControllerOptions) Validate() error {
@@ -256,6 +289,14 @@ func (o *ControllerOptions) Validate() error {
 		return fmt.Errorf("invalid default issuer kind: %v", o.DefaultIssuerKind)
 	}
 
+	if o.RenewBeforeExpiryPercentage < 0 || o.RenewBeforeExpiryPercentage > 99 {
+		return fmt.Errorf("invalid renew before expiry percentage: %d", o.RenewBeforeExpiryPercentage)
+	}
+
+	if o.RenewalJitterPercentage < 0 || o.RenewalJitterPercentage > 99 {
+		return fmt.Errorf("invalid renewal jitter percentage: %d", o.RenewalJitterPercentage)
+	}
+
 	for _, server := range o.DNS01RecursiveNameservers {
 		// ensure all servers have a port number
 		host, _, err := net.SplitHostPort(server)
@@ -0,0 +1,187 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clientset "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	informers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1alpha1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/ocspresponder"
+	"github.com/jetstack/cert-manager/pkg/util"
+)
+
+type OCSPResponderOptions struct {
+	Namespace                string
+	ClusterResourceNamespace string
+	ListenAddress            string
+	ResponseValidity         time.Duration
+
+	StdOut io.Writer
+	StdErr io.Writer
+}
+
+const (
+	defaultClusterResourceNamespace = "kube-system"
+	defaultListenAddress            = "0.0.0.0:8080"
+	defaultResponseValidity         = time.Hour
+)
+
+func (o *OCSPResponderOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Namespace, "namespace", "", ""+
+		"If set, this limits the scope of the OCSP responder to Issuers in a single namespace, "+
+		"and requests for ClusterIssuers will not be answered.")
+	fs.StringVar(&o.ClusterResourceNamespace, "cluster-resource-namespace", defaultClusterResourceNamespace, ""+
+		"Namespace that the Secrets referenced by ClusterIssuers are stored in. This must "+
+		"match the value configured for the cert-manager controller.")
+	fs.StringVar(&o.ListenAddress, "listen-address", defaultListenAddress, ""+
+		"The address to listen for OCSP requests on.")
+	fs.DurationVar(&o.ResponseValidity, "response-validity", defaultResponseValidity, ""+
+		"The duration that each OCSP response is valid for, after which clients should "+
+		"request a new response.")
+}
+
+func NewOCSPResponderOptions(out, errOut io.Writer) *OCSPResponderOptions {
+	o := &OCSPResponderOptions{
+		StdOut: out,
+		StdErr: errOut,
+	}
+
+	return o
+}
+
+// NewCommandStartOCSPResponder is a CLI handler for starting the OCSP responder
+func NewCommandStartOCSPResponder(out, errOut io.Writer, stopCh <-chan struct{}) *cobra.Command {
+	o := NewOCSPResponderOptions(out, errOut)
+
+	cmd := &cobra.Command{
+		Use:   "ocsp-responder",
+		Short: fmt.Sprintf("OCSP responder for cert-manager CA issuers (%s) (%s)", util.AppVersion, util.AppGitCommit),
+		Long: `
+cert-manager OCSP responder answers OCSP requests (RFC 6960) for certificates
+signed by CA Issuers and ClusterIssuers.
+
+The status of each certificate is determined from the state recorded by
+cert-manager: certificates revoked in the status of their issuer are reported
+as revoked, certificates recorded as issued in the status of their issuer or
+issued for a Certificate or CertificateRequest resource are reported as good,
+and all others are reported as unknown.
+
+Requests for an Issuer are answered at /issuers/<namespace>/<name>, and for a
+ClusterIssuer at /clusterissuers/<name>.`,
+		SilenceUsage: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			logf.Log.Info("starting ocsp-responder", "version", util.AppVersion, "git-commit", util.AppGitCommit)
+			return o.RunOCSPResponder(stopCh)
+		},
+	}
+
+	flags := cmd.Flags()
+	o.AddFlags(flags)
+
+	return cmd
+}
+
+func (o OCSPResponderOptions) Validate() error {
+	if o.ListenAddress == "" {
+		return fmt.Errorf("--listen-address must be set")
+	}
+	if o.ResponseValidity <= 0 {
+		return fmt.Errorf("invalid response validity: %s", o.ResponseValidity)
+	}
+	return nil
+}
+
+func (o OCSPResponderOptions) RunOCSPResponder(stopCh <-chan struct{}) error {
+	ctx := logf.NewContext(util.ContextWithStopCh(context.Background(), stopCh), nil, "ocsp-responder")
+
+	kubeCfg, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("error creating rest config: %v", err)
+	}
+	intcl, err := clientset.NewForConfig(kubeCfg)
+	if err != nil {
+		return fmt.Errorf("error creating internal group client: %v", err)
+	}
+	cl, err := kubernetes.NewForConfig(kubeCfg)
+	if err != nil {
+		return fmt.Errorf("error creating kubernetes client: %v", err)
+	}
+
+	sharedInformerFactory := informers.NewFilteredSharedInformerFactory(intcl, time.Second*30, o.Namespace, nil)
+	kubeSharedInformerFactory := kubeinformers.NewFilteredSharedInformerFactory(cl, time.Second*30, o.Namespace, nil)
+
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	// ClusterIssuers are disabled if scoped to a single namespace
+	if o.Namespace == "" {
+		clusterIssuerLister = sharedInformerFactory.Certmanager().V1alpha1().ClusterIssuers().Lister()
+	}
+
+	certificateInformer := sharedInformerFactory.Certmanager().V1alpha1().Certificates().Informer()
+	if err := certificateInformer.AddIndexers(cache.Indexers{ocspresponder.SerialNumberIndex: ocspresponder.CertificateSerialNumberIndexFunc}); err != nil {
+		return fmt.Errorf("error adding Certificate index: %v", err)
+	}
+	certificateRequestInformer := sharedInformerFactory.Certmanager().V1alpha1().CertificateRequests().Informer()
+	if err := certificateRequestInformer.AddIndexers(cache.Indexers{ocspresponder.SerialNumberIndex: ocspresponder.CertificateRequestSerialNumberIndexFunc}); err != nil {
+		return fmt.Errorf("error adding CertificateRequest index: %v", err)
+	}
+
+	responder := ocspresponder.New(ctx, ocspresponder.Options{
+		Address:                  o.ListenAddress,
+		ClusterResourceNamespace: o.ClusterResourceNamespace,
+		ResponseValidity:         o.ResponseValidity,
+	},
+		sharedInformerFactory.Certmanager().V1alpha1().Issuers().Lister(),
+		clusterIssuerLister,
+		kubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+		certificateInformer.GetIndexer(),
+		certificateRequestInformer.GetIndexer(),
+	)
+
+	sharedInformerFactory.Start(stopCh)
+	kubeSharedInformerFactory.Start(stopCh)
+	for t, ok := range sharedInformerFactory.WaitForCacheSync(stopCh) {
+		if !ok {
+			return fmt.Errorf("error waiting for %v informer cache to sync", t)
+		}
+	}
+	for t, ok := range kubeSharedInformerFactory.WaitForCacheSync(stopCh) {
+		if !ok {
+			return fmt.Errorf("error waiting for %v informer cache to sync", t)
+		}
+	}
+
+	responder.Start(stopCh)
+	return nil
+}
@@ -18,18 +18,38 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"io"
 	"os"
+	"runtime"
 	"time"
 
+	"github.com/openshift/generic-admission-server/pkg/apiserver"
 	"github.com/openshift/generic-admission-server/pkg/cmd"
+	"github.com/openshift/generic-admission-server/pkg/cmd/server"
+	"github.com/spf13/cobra"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/component-base/logs"
 	"k8s.io/klog"
 
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/validation/webhooks"
+	"github.com/jetstack/cert-manager/pkg/webhook/conversion"
+	"github.com/jetstack/cert-manager/pkg/webhook/defaulting"
 )
 
 var certHook cmd.ValidatingAdmissionHook = &webhooks.CertificateAdmissionHook{}
+var certRequestHook cmd.ValidatingAdmissionHook = &webhooks.CertificateRequestAdmissionHook{}
 var issuerHook cmd.ValidatingAdmissionHook = &webhooks.IssuerAdmissionHook{}
 var clusterIssuerHook cmd.ValidatingAdmissionHook = &webhooks.ClusterIssuerAdmissionHook{}
+var orderHook cmd.ValidatingAdmissionHook = &webhooks.OrderAdmissionHook{}
+var challengeHook cmd.ValidatingAdmissionHook = &webhooks.ChallengeAdmissionHook{}
+
+var certDefaultingHook cmd.MutatingAdmissionHook = defaulting.NewCertificateAdmissionHook()
+var issuerDefaultingHook cmd.MutatingAdmissionHook = defaulting.NewIssuerAdmissionHook()
+var clusterIssuerDefaultingHook cmd.MutatingAdmissionHook = defaulting.NewClusterIssuerAdmissionHook()
 
 func main() {
 	// Avoid "logging before flag.Parse" errors from glog
@@ -45,11 +65,108 @@ func main() {
 		runfilewatch(*tlsflagVal)
 	}
 
-	cmd.RunAdmissionServer(
+	logs.InitLogs()
+	defer logs.FlushLogs()
+
+	if len(os.Getenv("GOMAXPROCS")) == 0 {
+		runtime.GOMAXPROCS(runtime.NumCPU())
+	}
+
+	stopCh := genericapiserver.SetupSignalHandler()
+	cmd := newCommandStartWebhookServer(os.Stdout, os.Stderr, stopCh,
 		certHook,
+		certRequestHook,
 		issuerHook,
 		clusterIssuerHook,
+		orderHook,
+		challengeHook,
+		certDefaultingHook,
+		issuerDefaultingHook,
+		clusterIssuerDefaultingHook,
 	)
+	cmd.Flags().AddGoFlagSet(flag.CommandLine)
+	if err := cmd.Execute(); err != nil {
+		klog.Fatal(err)
+	}
+}
+
+// newCommandStartWebhookServer returns a command that runs the admission
+// webhook server with the given hooks, as well as the conversion webhook for
+// the cert-manager CustomResourceDefinitions.
+func newCommandStartWebhookServer(out, errOut io.Writer, stopCh <-chan struct{}, hooks ...cmd.AdmissionHook) *cobra.Command {
+	var admissionHooks []apiserver.AdmissionHook
+	for _, h := range hooks {
+		admissionHooks = append(admissionHooks, h)
+	}
+
+	o := server.NewAdmissionServerOptions(out, errOut, admissionHooks...)
+	// The apiserver does not present credentials when calling conversion
+	// webhooks, so requests to the conversion endpoint cannot be authorized.
+	o.RecommendedOptions.Authorization.WithAlwaysAllowPaths(conversion.WebhookPath)
+
+	var conversionService, conversionCAFrom string
+
+	c := &cobra.Command{
+		Short: "Launch the cert-manager webhook server",
+		Long:  "Launch the cert-manager webhook server",
+		RunE: func(c *cobra.Command, args []string) error {
+			config, err := o.Config()
+			if err != nil {
+				return err
+			}
+
+			s, err := config.Complete().New()
+			if err != nil {
+				return err
+			}
+
+			s.GenericAPIServer.Handler.NonGoRestfulMux.Handle(conversion.WebhookPath, conversion.NewWebhook())
+
+			if conversionService != "" {
+				configurer, err := newCRDConfigurer(config.GenericConfig.ClientConfig, conversionService, conversionCAFrom)
+				if err != nil {
+					return err
+				}
+				go configurer.Run(stopCh)
+			}
+
+			return s.GenericAPIServer.PrepareRun().Run(stopCh)
+		},
+	}
+	o.RecommendedOptions.AddFlags(c.Flags())
+	c.Flags().StringVar(&conversionService, "conversion-service", "", ""+
+		"The namespace/name of the Service that the webhook is served behind. If set, the cert-manager "+
+		"CustomResourceDefinitions are configured to convert resources between API versions using the webhook.")
+	c.Flags().StringVar(&conversionCAFrom, "conversion-ca-from", "", ""+
+		"The namespace/name of the Certificate whose CA is injected into the CustomResourceDefinitions' "+
+		"conversion webhook configuration by the cainjector.")
+
+	return c
+}
+
+// newCRDConfigurer returns a CRDConfigurer that configures the
+// CustomResourceDefinitions to use the conversion webhook served behind the
+// given namespace/name Service.
+func newCRDConfigurer(restConfig *rest.Config, service, caFrom string) (*conversion.CRDConfigurer, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(service)
+	if err != nil || namespace == "" || name == "" {
+		return nil, fmt.Errorf("invalid --conversion-service %q, must be of the form namespace/name", service)
+	}
+	if caFrom == "" {
+		return nil, fmt.Errorf("--conversion-ca-from must be set when --conversion-service is set")
+	}
+
+	cl, err := apiextensionsclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CustomResourceDefinition client: %v", err)
+	}
+
+	return &conversion.CRDConfigurer{
+		Client:           cl.ApiextensionsV1beta1(),
+		ServiceNamespace: namespace,
+		ServiceName:      name,
+		CAFrom:           caFrom,
+	}, nil
 }
 
 func runfilewatch(filename string) {
@@ -36,4 +36,10 @@ generate-groups.sh "deepcopy,client,informer,lister" \
   --output-base "${GOPATH}/src/" \
   --go-header-file "${runfiles}/hack/boilerplate/boilerplate.go.txt"
 
+generate-internal-groups.sh "deepcopy,defaulter,conversion" \
+  github.com/jetstack/cert-manager/pkg/apis github.com/jetstack/cert-manager/pkg/apis \
+  certmanager:v1alpha1,v1alpha2 \
+  --output-base "${GOPATH}/src/" \
+  --go-header-file "${runfiles}/hack/boilerplate/boilerplate.go.txt"
+
 update-bazel.sh
@@ -34,7 +34,11 @@ out="deploy/manifests/00-crds.yaml"
 rm "$out" > /dev/null 2>&1 || true
 mkdir -p "$(dirname $out)"
 touch "$out"
+# gencrd derives the API group from the name of the package directory, so the
+# group is renamed to cert-manager.io as the files are copied.
 for file in ${output}/*; do
-    cat "$file" >> "$out"
+    sed -e 's/^  name: \([a-z]*\)\.certmanager\.k8s\.io$/  name: \1.cert-manager.io/' \
+        -e 's/^  group: certmanager\.k8s\.io$/  group: cert-manager.io/' \
+        "$file" >> "$out"
     echo "---" >> "$out"
 done
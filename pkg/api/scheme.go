@@ -17,6 +17,7 @@ limitations under the License.
 package api
 
 import (
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -37,6 +38,7 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	whapi.AddToScheme,
 	kscheme.AddToScheme,
 	apireg.AddToScheme,
+	apiext.AddToScheme,
 }
 
 // AddToScheme adds all types of this clientset into the given scheme. This allows composition
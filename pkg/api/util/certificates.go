@@ -0,0 +1,82 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+const (
+	// CertificateIssuanceInitialBackoff is the time to wait before retrying
+	// the first failed attempt to issue a certificate.
+	CertificateIssuanceInitialBackoff = time.Hour
+
+	// CertificateIssuanceMaxBackoff is the maximum time to wait before
+	// retrying a failed attempt to issue a certificate.
+	CertificateIssuanceMaxBackoff = time.Hour * 32
+)
+
+// NextPrivateKeySecretName returns the name of the Secret resource used to
+// store the private key that will be used for the next issuance of the given
+// Certificate, when its private key is being rotated.
+func NextPrivateKeySecretName(crt *cmapi.Certificate) string {
+	return crt.Name + "-next-private-key"
+}
+
+// PrivateKeyRotationPolicy returns the private key rotation policy of the
+// given Certificate, defaulting to Never.
+func PrivateKeyRotationPolicy(crt *cmapi.Certificate) cmapi.PrivateKeyRotationPolicy {
+	if crt.Spec.PrivateKey == nil || crt.Spec.PrivateKey.RotationPolicy == "" {
+		return cmapi.RotationPolicyNever
+	}
+	return crt.Spec.PrivateKey.RotationPolicy
+}
+
+// PrivateKeySecretRef returns a reference to the existing Secret that
+// contains the private key to be used for the given Certificate, or nil if the
+// private key is managed by cert-manager. If the reference does not name a
+// key within the Secret, 'tls.key' is used.
+func PrivateKeySecretRef(crt *cmapi.Certificate) *cmapi.SecretKeySelector {
+	if crt.Spec.PrivateKey == nil || crt.Spec.PrivateKey.SecretRef == nil {
+		return nil
+	}
+	ref := crt.Spec.PrivateKey.SecretRef.DeepCopy()
+	if ref.Key == "" {
+		ref.Key = corev1.TLSPrivateKeyKey
+	}
+	return ref
+}
+
+// CertificateIssuanceBackoff returns the time to wait after the last failed
+// attempt to issue a certificate for the given Certificate before retrying.
+// The back-off doubles with each consecutive failed attempt, starting from
+// CertificateIssuanceInitialBackoff and capped at
+// CertificateIssuanceMaxBackoff.
+func CertificateIssuanceBackoff(crt *cmapi.Certificate) time.Duration {
+	backoff := CertificateIssuanceInitialBackoff
+	for i := int32(1); i < crt.Status.FailedIssuanceAttempts; i++ {
+		backoff *= 2
+		if backoff >= CertificateIssuanceMaxBackoff {
+			return CertificateIssuanceMaxBackoff
+		}
+	}
+	return backoff
+}
@@ -18,6 +18,10 @@ package util
 
 import (
 	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
 )
@@ -52,3 +56,79 @@ func NameForIssuer(i cmapi.GenericIssuer) (string, error) {
 	}
 	return "", fmt.Errorf("no issuer specified for Issuer '%s/%s'", i.GetObjectMeta().Namespace, i.GetObjectMeta().Name)
 }
+
+// RevokeCertificate records the revocation of the certificate with the given
+// serial number and expiry time in the status of a CA Issuer. It returns
+// false if the certificate has already been revoked.
+func RevokeCertificate(i cmapi.GenericIssuer, serialNumber string, revocationTime metav1.Time, notAfter *metav1.Time) bool {
+	status := i.GetStatus()
+	if status.CA == nil {
+		status.CA = &cmapi.CAIssuerStatus{}
+	}
+	for _, r := range status.CA.RevokedCertificates {
+		if strings.EqualFold(r.SerialNumber, serialNumber) {
+			return false
+		}
+	}
+	status.CA.RevokedCertificates = append(status.CA.RevokedCertificates, cmapi.RevokedCertificate{
+		SerialNumber:   serialNumber,
+		RevocationTime: revocationTime,
+		NotAfter:       notAfter,
+	})
+	return true
+}
+
+// PruneExpiredCertificates removes the certificates that have expired by now
+// from the revoked and issued certificate lists in the status of a CA Issuer.
+// Revoked certificates without a known expiry time are kept. It returns true
+// if any entry was removed.
+func PruneExpiredCertificates(i cmapi.GenericIssuer, now time.Time) bool {
+	status := i.GetStatus()
+	if status.CA == nil {
+		return false
+	}
+	pruned := false
+	var revoked []cmapi.RevokedCertificate
+	for _, r := range status.CA.RevokedCertificates {
+		if r.NotAfter != nil && r.NotAfter.Time.Before(now) {
+			pruned = true
+			continue
+		}
+		revoked = append(revoked, r)
+	}
+	var issued []cmapi.IssuedCertificate
+	for _, c := range status.CA.IssuedCertificates {
+		if c.NotAfter.Time.Before(now) {
+			pruned = true
+			continue
+		}
+		issued = append(issued, c)
+	}
+	if pruned {
+		status.CA.RevokedCertificates = revoked
+		status.CA.IssuedCertificates = issued
+	}
+	return pruned
+}
+
+// RecordIssuedCertificate records a certificate signed by a CA Issuer in its
+// status, so that the OCSP responder can report it as good. Records of
+// certificates that have expired by now are removed.
+func RecordIssuedCertificate(i cmapi.GenericIssuer, serialNumber string, notAfter metav1.Time, now time.Time) {
+	status := i.GetStatus()
+	if status.CA == nil {
+		status.CA = &cmapi.CAIssuerStatus{}
+	}
+	PruneExpiredCertificates(i, now)
+	var issued []cmapi.IssuedCertificate
+	for _, c := range status.CA.IssuedCertificates {
+		if strings.EqualFold(c.SerialNumber, serialNumber) {
+			continue
+		}
+		issued = append(issued, c)
+	}
+	status.CA.IssuedCertificates = append(issued, cmapi.IssuedCertificate{
+		SerialNumber: serialNumber,
+		NotAfter:     notAfter,
+	})
+}
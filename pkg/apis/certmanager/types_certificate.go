@@ -0,0 +1,544 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Certificate is a type to represent a Certificate from ACME
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateSpec   `json:"spec,omitempty"`
+	Status CertificateStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateList is a list of Certificates
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Certificate `json:"items"`
+}
+
+type KeyAlgorithm string
+
+const (
+	RSAKeyAlgorithm     KeyAlgorithm = "rsa"
+	ECDSAKeyAlgorithm   KeyAlgorithm = "ecdsa"
+	Ed25519KeyAlgorithm KeyAlgorithm = "ed25519"
+)
+
+// PrivateKeyRotationPolicy denotes how private keys should be generated or
+// sourced when a Certificate is being issued.
+type PrivateKeyRotationPolicy string
+
+const (
+	// RotationPolicyNever means a private key will only be generated if one
+	// does not already exist in the target Secret resource. The existing key
+	// will be reused on every renewal.
+	RotationPolicyNever PrivateKeyRotationPolicy = "Never"
+	// RotationPolicyAlways means a new private key will be generated each
+	// time a certificate is issued for the Certificate.
+	RotationPolicyAlways PrivateKeyRotationPolicy = "Always"
+)
+
+// KeyEncoding is the format used to encode the private key stored in the
+// target Secret.
+type KeyEncoding string
+
+const (
+	// PKCS1 encodes RSA keys in PKCS#1 format and ECDSA keys in SEC 1
+	// format.
+	PKCS1 KeyEncoding = "pkcs1"
+	// PKCS8 encodes all keys in PKCS#8 format.
+	PKCS8 KeyEncoding = "pkcs8"
+)
+
+// KeyUsage specifies valid usage contexts for keys.
+// See: https://tools.ietf.org/html/rfc5280#section-4.2.1.3
+//      https://tools.ietf.org/html/rfc5280#section-4.2.1.12
+type KeyUsage string
+
+const (
+	UsageSigning           KeyUsage = "signing"
+	UsageDigitalSignature  KeyUsage = "digital signature"
+	UsageContentCommitment KeyUsage = "content commitment"
+	UsageKeyEncipherment   KeyUsage = "key encipherment"
+	UsageKeyAgreement      KeyUsage = "key agreement"
+	UsageDataEncipherment  KeyUsage = "data encipherment"
+	UsageCertSign          KeyUsage = "cert sign"
+	UsageCRLSign           KeyUsage = "crl sign"
+	UsageEncipherOnly      KeyUsage = "encipher only"
+	UsageDecipherOnly      KeyUsage = "decipher only"
+	UsageAny               KeyUsage = "any"
+	UsageServerAuth        KeyUsage = "server auth"
+	UsageClientAuth        KeyUsage = "client auth"
+	UsageCodeSigning       KeyUsage = "code signing"
+	UsageEmailProtection   KeyUsage = "email protection"
+	UsageSMIME             KeyUsage = "s/mime"
+	UsageIPsecEndSystem    KeyUsage = "ipsec end system"
+	UsageIPsecTunnel       KeyUsage = "ipsec tunnel"
+	UsageIPsecUser         KeyUsage = "ipsec user"
+	UsageTimestamping      KeyUsage = "timestamping"
+	UsageOCSPSigning       KeyUsage = "ocsp signing"
+	UsageMicrosoftSGC      KeyUsage = "microsoft sgc"
+	UsageNetscapeSGC       KeyUsage = "netscape sgc"
+)
+
+// CertificateSpec defines the desired state of Certificate
+type CertificateSpec struct {
+	// CommonName is a common name to be used on the Certificate
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+
+	// Organization is the organization to be used on the Certificate
+	// +optional
+	Organization []string `json:"organization,omitempty"`
+
+	// Subject is the full X.509 subject to be used on the Certificate. The
+	// CommonName and Organization fields above are used in addition to the
+	// values set here.
+	// +optional
+	Subject *X509Subject `json:"subject,omitempty"`
+
+	// Certificate default Duration
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Certificate renew before expiration duration
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+
+	// RenewBeforePercentage is the percentage of the certificate's total
+	// duration that should remain when it is renewed. For example, a value of
+	// 33 will cause a certificate to be renewed after two thirds of its
+	// lifetime has passed, regardless of its duration.
+	// It must be between 1 and 99, and may not be set if renewBefore is set.
+	// +optional
+	RenewBeforePercentage *int32 `json:"renewBeforePercentage,omitempty"`
+
+	// DNSNames is a list of subject alt names to be used on the Certificate
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// IPAddresses is a list of IP addresses to be used on the Certificate
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// URISANs is a list of URI subject alt names to be used on the
+	// Certificate, for example SPIFFE IDs
+	// +optional
+	URISANs []string `json:"uriSANs,omitempty"`
+
+	// EmailSANs is a list of email subject alt names to be used on the
+	// Certificate
+	// +optional
+	EmailSANs []string `json:"emailSANs,omitempty"`
+
+	// SecretName is the name of the secret resource to store this secret in
+	SecretName string `json:"secretName"`
+
+	// SecretTemplate defines annotations and labels to be copied to the
+	// Certificate's Secret. Labels and annotations on the Secret will be
+	// changed as they appear on the SecretTemplate when added or changed.
+	// Labels and annotations removed from the SecretTemplate are not removed
+	// from the Secret.
+	// +optional
+	SecretTemplate *CertificateSecretTemplate `json:"secretTemplate,omitempty"`
+
+	// IssuerRef is a reference to the issuer for this certificate.
+	// If the 'kind' field is not set, or set to 'Issuer', an Issuer resource
+	// with the given name in the same namespace as the Certificate will be used.
+	// If the 'kind' field is set to 'ClusterIssuer', a ClusterIssuer with the
+	// provided name will be used.
+	// The 'name' field in this stanza is required at all times.
+	IssuerRef ObjectReference `json:"issuerRef"`
+
+	// IsCA will mark this Certificate as valid for signing.
+	// This implies that the 'signing' usage is set
+	// +optional
+	IsCA bool `json:"isCA,omitempty"`
+
+	// MaxPathLen is the maximum number of intermediate CA certificates that
+	// may follow this certificate in a valid certification path. A value of
+	// 0 means that this CA may only sign end-entity certificates.
+	// If not set, the path length will not be constrained.
+	// May only be set if isCA is true.
+	// +optional
+	MaxPathLen *int32 `json:"maxPathLen,omitempty"`
+
+	// NameConstraints restricts the names for which this CA, and any CA
+	// certificates it signs, may issue certificates.
+	// May only be set if isCA is true.
+	// +optional
+	NameConstraints *NameConstraints `json:"nameConstraints,omitempty"`
+
+	// ACME contains configuration specific to ACME Certificates.
+	// Notably, this contains details on how the domain names listed on this
+	// Certificate resource should be 'solved', i.e. mapping HTTP01 and DNS01
+	// providers to DNS names.
+	// +optional
+	ACME *ACMECertificateConfig `json:"acme,omitempty"`
+
+	// KeySize is the key bit size of the corresponding private key for this certificate.
+	// If provided, value must be between 2048 and 8192 inclusive when KeyAlgorithm is
+	// empty or is set to "rsa", and value must be one of (256, 384, 521) when
+	// KeyAlgorithm is set to "ecdsa". KeySize must not be set when KeyAlgorithm
+	// is set to "ed25519".
+	// +optional
+	KeySize int `json:"keySize,omitempty"`
+
+	// KeyAlgorithm is the private key algorithm of the corresponding private key
+	// for this certificate. If provided, allowed values are "rsa", "ecdsa" or
+	// "ed25519".
+	// If KeyAlgorithm is specified and KeySize is not provided,
+	// key size of 256 will be used for "ecdsa" key algorithm and
+	// key size of 2048 will be used for "rsa" key algorithm.
+	// +optional
+	KeyAlgorithm KeyAlgorithm `json:"keyAlgorithm,omitempty"`
+
+	// PrivateKey contains options for the private key of the Certificate.
+	// +optional
+	PrivateKey *CertificatePrivateKey `json:"privateKey,omitempty"`
+
+	// KeyEncoding is the private key encoding used when storing the private
+	// key in the target Secret. If provided, allowed values are "pkcs1" and
+	// "pkcs8". If not set, "pkcs1" will be used, which encodes RSA keys in
+	// PKCS#1 and ECDSA keys in SEC 1 format. Ed25519 keys are always encoded
+	// in PKCS#8 format.
+	// Changing this field will re-encode the existing private key without
+	// re-issuing the certificate.
+	// +optional
+	KeyEncoding KeyEncoding `json:"keyEncoding,omitempty"`
+
+	// Usages is the set of x509 key usages and extended key usages that
+	// should be set on the issued certificate.
+	// If not set, the 'digital signature' and 'key encipherment' usages will
+	// be used.
+	// +optional
+	Usages []KeyUsage `json:"usages,omitempty"`
+
+	// Keystores configures additional keystore output formats stored in the
+	// `secretName` Secret resource.
+	// +optional
+	Keystores *CertificateKeystores `json:"keystores,omitempty"`
+
+	// AdditionalOutputFormats defines extra output formats of the private key
+	// and signed certificate chain to be written to this Certificate's target
+	// Secret.
+	// +optional
+	AdditionalOutputFormats []CertificateAdditionalOutputFormat `json:"additionalOutputFormats,omitempty"`
+}
+
+// CertificatePrivateKey contains configuration options for the private key
+// of a Certificate.
+type CertificatePrivateKey struct {
+	// RotationPolicy controls how private keys should be regenerated when a
+	// re-issuance is being processed.
+	// If set to Never, a private key will only be generated if one does not
+	// already exist in the target `secretName`.
+	// If set to Always, a private key matching the specified requirements
+	// will be generated whenever a re-issuance occurs. The existing private
+	// key is only replaced once the new certificate has been issued.
+	// Default is 'Never' for backward compatibility.
+	// +optional
+	RotationPolicy PrivateKeyRotationPolicy `json:"rotationPolicy,omitempty"`
+
+	// SecretRef is a reference to a key in an existing Secret resource, in
+	// the same namespace as the Certificate, that contains a PEM encoded
+	// private key to use for the certificate. If set, cert-manager will never
+	// generate a private key for this Certificate, and will refuse to issue a
+	// certificate if the private key does not match the keyAlgorithm and
+	// keySize of the Certificate.
+	// If the 'key' field is not set, 'tls.key' will be used.
+	// The rotationPolicy may not be set to Always if this field is set.
+	// +optional
+	SecretRef *SecretKeySelector `json:"secretRef,omitempty"`
+}
+
+// NameConstraints is the x509 name constraints extension of a CA certificate,
+// as defined in RFC 5280 section 4.2.1.10.
+type NameConstraints struct {
+	// Critical marks the name constraints extension as critical.
+	// +optional
+	Critical bool `json:"critical,omitempty"`
+
+	// Permitted contains the only names that certificates in the certification
+	// path may contain.
+	// +optional
+	Permitted *NameConstraintItem `json:"permitted,omitempty"`
+
+	// Excluded contains names that certificates in the certification path may
+	// not contain, even if they are also permitted.
+	// +optional
+	Excluded *NameConstraintItem `json:"excluded,omitempty"`
+}
+
+// NameConstraintItem is a set of names of each type that may appear in a
+// name constraint.
+type NameConstraintItem struct {
+	// DNSDomains is a list of DNS domains. A domain matches itself and all of
+	// its subdomains.
+	// +optional
+	DNSDomains []string `json:"dnsDomains,omitempty"`
+
+	// IPRanges is a list of IP address ranges in CIDR notation.
+	// +optional
+	IPRanges []string `json:"ipRanges,omitempty"`
+
+	// EmailAddresses is a list of email addresses, hosts or domains.
+	// +optional
+	EmailAddresses []string `json:"emailAddresses,omitempty"`
+
+	// URIDomains is a list of domains that the host component of URIs must
+	// match.
+	// +optional
+	URIDomains []string `json:"uriDomains,omitempty"`
+}
+
+// CertificateSecretTemplate defines the default labels and annotations
+// to be copied to the Kubernetes Secret resource named in
+// `CertificateSpec.secretName`.
+type CertificateSecretTemplate struct {
+	// Annotations is a key value map to be copied to the target Kubernetes
+	// Secret.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Labels is a key value map to be copied to the target Kubernetes Secret.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// CertificateOutputFormatType specifies which additional output formats
+// should be written to the Certificate's target Secret.
+type CertificateOutputFormatType string
+
+const (
+	// CertificateOutputFormatDER writes the Certificate's private key in DER
+	// format to the `key.der` entry of the target Secret. The private key is
+	// encoded using the Certificate's key encoding.
+	CertificateOutputFormatDER CertificateOutputFormatType = "DER"
+
+	// CertificateOutputFormatCombinedPEM writes the Certificate's private key
+	// followed by the signed certificate chain, both PEM encoded, to the
+	// `tls-combined.pem` entry of the target Secret.
+	CertificateOutputFormatCombinedPEM CertificateOutputFormatType = "CombinedPEM"
+)
+
+// CertificateAdditionalOutputFormat defines an additional output format of a
+// Certificate resource. These contain supplementary data formats of the signed
+// certificate chain and paired private key.
+type CertificateAdditionalOutputFormat struct {
+	// Type is the name of the format type that should be written to the
+	// Certificate's target Secret.
+	Type CertificateOutputFormatType `json:"type"`
+}
+
+// CertificateKeystores configures additional keystore output formats to be
+// created in the Certificate's output Secret.
+type CertificateKeystores struct {
+	// JKS configures options for storing a JKS keystore in the `secretName`
+	// Secret resource.
+	// +optional
+	JKS *JKSKeystore `json:"jks,omitempty"`
+
+	// PKCS12 configures options for storing a PKCS#12 keystore in the
+	// `secretName` Secret resource.
+	// +optional
+	PKCS12 *PKCS12Keystore `json:"pkcs12,omitempty"`
+}
+
+// JKSKeystore configures options for storing a JKS keystore in the
+// `secretName` Secret resource.
+type JKSKeystore struct {
+	// Create enables JKS keystore creation for the Certificate.
+	// If true, a file named `keystore.jks` will be created in the target
+	// Secret resource, encrypted using the password stored in
+	// `passwordSecretRef`. The keystore contains the private key along with
+	// the certificate chain. If the issuing CA is known, a file named
+	// `truststore.jks` containing the CA will also be created.
+	Create bool `json:"create"`
+
+	// PasswordSecretRef is a reference to a key in a Secret resource
+	// containing the password used to encrypt the JKS keystore.
+	PasswordSecretRef SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// PKCS12Keystore configures options for storing a PKCS#12 keystore in the
+// `secretName` Secret resource.
+type PKCS12Keystore struct {
+	// Create enables PKCS#12 keystore creation for the Certificate.
+	// If true, a file named `keystore.p12` will be created in the target
+	// Secret resource, encrypted using the password stored in
+	// `passwordSecretRef`. The keystore contains the private key along with
+	// the certificate chain and, if known, the issuing CA.
+	Create bool `json:"create"`
+
+	// PasswordSecretRef is a reference to a key in a Secret resource
+	// containing the password used to encrypt the PKCS#12 keystore.
+	PasswordSecretRef SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// X509Subject contains the X.509 distinguished name attributes, other than
+// the common name and organization, to be used on a Certificate.
+type X509Subject struct {
+	// Countries to be used on the Certificate.
+	// +optional
+	Countries []string `json:"countries,omitempty"`
+
+	// Organizational Units to be used on the Certificate.
+	// +optional
+	OrganizationalUnits []string `json:"organizationalUnits,omitempty"`
+
+	// Cities to be used on the Certificate.
+	// +optional
+	Localities []string `json:"localities,omitempty"`
+
+	// State/Provinces to be used on the Certificate.
+	// +optional
+	Provinces []string `json:"provinces,omitempty"`
+
+	// Street addresses to be used on the Certificate.
+	// +optional
+	StreetAddresses []string `json:"streetAddresses,omitempty"`
+
+	// Postal codes to be used on the Certificate.
+	// +optional
+	PostalCodes []string `json:"postalCodes,omitempty"`
+
+	// Serial number to be used on the Certificate.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+}
+
+// ACMECertificateConfig contains the configuration for the ACME certificate provider
+type ACMECertificateConfig struct {
+	Config []DomainSolverConfig `json:"config"`
+}
+
+// CertificateStatus defines the observed state of Certificate
+type CertificateStatus struct {
+	// +optional
+	Conditions []CertificateCondition `json:"conditions,omitempty"`
+
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+
+	// FailedIssuanceAttempts is the number of consecutive failed attempts to
+	// issue a certificate for this resource. It is used to exponentially
+	// back-off retries, and is reset once a certificate has been issued.
+	// +optional
+	FailedIssuanceAttempts int32 `json:"failedIssuanceAttempts,omitempty"`
+
+	// The expiration time of the certificate stored in the secret named
+	// by this resource in spec.secretName.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// The time after which the certificate stored in the secret named by this
+	// resource in spec.secretName is valid.
+	// +optional
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// RenewalTime is the time at which the certificate will be next renewed.
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+
+	// SerialNumber is the upper-case hex encoded serial number of the
+	// certificate stored in the secret named by this resource in
+	// spec.secretName.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// Fingerprint is the SHA-256 fingerprint of the DER encoded certificate
+	// stored in the secret named by this resource in spec.secretName,
+	// formatted as colon separated upper-case hex bytes.
+	// +optional
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// Revision is incremented each time a certificate is successfully
+	// issued for this resource and stored in the secret named by
+	// spec.secretName.
+	// +optional
+	Revision *int `json:"revision,omitempty"`
+}
+
+// CertificateCondition contains condition information for an Certificate.
+type CertificateCondition struct {
+	// Type of the condition, currently ('Ready').
+	Type CertificateConditionType `json:"type"`
+
+	// Status of the condition, one of ('True', 'False', 'Unknown').
+	Status ConditionStatus `json:"status"`
+
+	// LastTransitionTime is the timestamp corresponding to the last status
+	// change of this condition.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a brief machine readable explanation for the condition's last
+	// transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable description of the details of the last
+	// transition, complementing reason.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CertificateConditionType represents an Certificate condition value.
+type CertificateConditionType string
+
+const (
+	// CertificateConditionReady indicates that a certificate is ready for use.
+	// This is defined as:
+	// - The target secret exists
+	// - The target secret contains a certificate that has not expired
+	// - The target secret contains a private key valid for the certificate
+	// - The commonName and dnsNames attributes match those specified on the Certificate
+	CertificateConditionReady CertificateConditionType = "Ready"
+
+	// CertificateConditionIssuing can be set to True by a user to request
+	// that a certificate is re-issued immediately, regardless of when it
+	// would otherwise be renewed, e.g. after a private key has been
+	// compromised. Once a new certificate has been issued, the condition will
+	// be set to False by cert-manager.
+	CertificateConditionIssuing CertificateConditionType = "Issuing"
+)
+
+const (
+	// CertificateReasonManuallyTriggered should be set as the reason of the
+	// Issuing condition when re-issuance is requested by a user.
+	CertificateReasonManuallyTriggered = "ManuallyTriggered"
+
+	// CertificateReasonInProgress is set as the reason of the Issuing
+	// condition by cert-manager once it has observed a request for
+	// re-issuance and started issuing a new certificate.
+	CertificateReasonInProgress = "InProgress"
+
+	// CertificateReasonIssued is set as the reason of the Issuing condition
+	// once a new certificate has been issued following a request for
+	// re-issuance.
+	CertificateReasonIssued = "Issued"
+)
@@ -30,8 +30,35 @@ const (
 
 	// Default duration before certificate expiration if  Issuer.spec.renewBefore is not set
 	DefaultRenewBefore = time.Hour * 24 * 30
+
+	// minimum permitted period between updates of a CA issuer's CRL
+	MinimumCRLUpdatePeriod = time.Minute * 5
+
+	// default period between updates of a CA issuer's CRL if
+	// Issuer.spec.ca.crl.updatePeriod is not set
+	DefaultCRLUpdatePeriod = time.Hour * 24
+)
+
+const (
+	// default private key size if Certificate.spec.keySize is not set and
+	// the rsa key algorithm is used
+	DefaultRSAKeySize = 2048
+
+	// default private key size if Certificate.spec.keySize is not set and
+	// the ecdsa key algorithm is used
+	DefaultECDSAKeySize = 256
+
+	// default path that the Vault AppRole auth backend is mounted on
+	DefaultVaultAppRolePath = "approle"
+
+	// default key in the Vault token Secret that holds the token
+	DefaultVaultTokenSecretKey = "token"
 )
 
 const (
 	ACMEFinalizer = "finalizer.acme.cert-manager.io"
+
+	// RevokeOnDeleteFinalizer is added to Certificates whose CA issuer revokes
+	// certificates on deletion, until the certificate has been revoked
+	RevokeOnDeleteFinalizer = "finalizer.revoke.cert-manager.io"
 )
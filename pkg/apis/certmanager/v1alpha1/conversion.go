@@ -17,10 +17,297 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
 )
 
 func addConversionFuncs(scheme *runtime.Scheme) error {
 	// Add non-generated conversion functions
 	return scheme.AddConversionFuncs()
 }
+
+// Convert_v1alpha1_VaultAuth_To_certmanager_VaultAuth converts the v1alpha1
+// VaultAuth structure, which always embeds both authentication methods, into
+// the internal representation where unset methods are nil.
+func Convert_v1alpha1_VaultAuth_To_certmanager_VaultAuth(in *VaultAuth, out *certmanager.VaultAuth, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_VaultAuth_To_certmanager_VaultAuth(in, out, s); err != nil {
+		return err
+	}
+
+	out.TokenSecretRef = nil
+	if in.TokenSecretRef != (SecretKeySelector{}) {
+		out.TokenSecretRef = new(certmanager.SecretKeySelector)
+		if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.TokenSecretRef, out.TokenSecretRef, s); err != nil {
+			return err
+		}
+	}
+
+	out.AppRole = nil
+	if in.AppRole != (VaultAppRole{}) {
+		out.AppRole = new(certmanager.VaultAppRole)
+		if err := Convert_v1alpha1_VaultAppRole_To_certmanager_VaultAppRole(&in.AppRole, out.AppRole, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Convert_certmanager_VaultAuth_To_v1alpha1_VaultAuth converts the internal
+// VaultAuth structure into the v1alpha1 representation, where unset
+// authentication methods are represented by their zero value.
+func Convert_certmanager_VaultAuth_To_v1alpha1_VaultAuth(in *certmanager.VaultAuth, out *VaultAuth, s conversion.Scope) error {
+	if err := autoConvert_certmanager_VaultAuth_To_v1alpha1_VaultAuth(in, out, s); err != nil {
+		return err
+	}
+
+	out.TokenSecretRef = SecretKeySelector{}
+	if in.TokenSecretRef != nil {
+		if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(in.TokenSecretRef, &out.TokenSecretRef, s); err != nil {
+			return err
+		}
+	}
+
+	out.AppRole = VaultAppRole{}
+	if in.AppRole != nil {
+		if err := Convert_certmanager_VaultAppRole_To_v1alpha1_VaultAppRole(in.AppRole, &out.AppRole, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The internal secret reference fields are named after their JSON field
+// names. The following functions convert between them and the v1alpha1
+// fields, which are named after the secret they reference.
+
+// Convert_v1alpha1_ACMEIssuer_To_certmanager_ACMEIssuer converts the v1alpha1 ACMEIssuer
+// structure into the internal representation.
+func Convert_v1alpha1_ACMEIssuer_To_certmanager_ACMEIssuer(in *ACMEIssuer, out *certmanager.ACMEIssuer, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ACMEIssuer_To_certmanager_ACMEIssuer(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.PrivateKey, &out.PrivateKeySecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ACMEIssuer_To_v1alpha1_ACMEIssuer converts the internal ACMEIssuer
+// structure into the v1alpha1 representation.
+func Convert_certmanager_ACMEIssuer_To_v1alpha1_ACMEIssuer(in *certmanager.ACMEIssuer, out *ACMEIssuer, s conversion.Scope) error {
+	if err := autoConvert_certmanager_ACMEIssuer_To_v1alpha1_ACMEIssuer(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(&in.PrivateKeySecretRef, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ACMEIssuerDNS01ProviderAkamai_To_certmanager_ACMEIssuerDNS01ProviderAkamai converts the v1alpha1 ACMEIssuerDNS01ProviderAkamai
+// structure into the internal representation.
+func Convert_v1alpha1_ACMEIssuerDNS01ProviderAkamai_To_certmanager_ACMEIssuerDNS01ProviderAkamai(in *ACMEIssuerDNS01ProviderAkamai, out *certmanager.ACMEIssuerDNS01ProviderAkamai, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ACMEIssuerDNS01ProviderAkamai_To_certmanager_ACMEIssuerDNS01ProviderAkamai(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.ClientToken, &out.ClientTokenSecretRef, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.ClientSecret, &out.ClientSecretSecretRef, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.AccessToken, &out.AccessTokenSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ACMEIssuerDNS01ProviderAkamai_To_v1alpha1_ACMEIssuerDNS01ProviderAkamai converts the internal ACMEIssuerDNS01ProviderAkamai
+// structure into the v1alpha1 representation.
+func Convert_certmanager_ACMEIssuerDNS01ProviderAkamai_To_v1alpha1_ACMEIssuerDNS01ProviderAkamai(in *certmanager.ACMEIssuerDNS01ProviderAkamai, out *ACMEIssuerDNS01ProviderAkamai, s conversion.Scope) error {
+	if err := autoConvert_certmanager_ACMEIssuerDNS01ProviderAkamai_To_v1alpha1_ACMEIssuerDNS01ProviderAkamai(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(&in.ClientTokenSecretRef, &out.ClientToken, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(&in.ClientSecretSecretRef, &out.ClientSecret, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(&in.AccessTokenSecretRef, &out.AccessToken, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ACMEIssuerDNS01ProviderCloudDNS_To_certmanager_ACMEIssuerDNS01ProviderCloudDNS converts the v1alpha1 ACMEIssuerDNS01ProviderCloudDNS
+// structure into the internal representation.
+func Convert_v1alpha1_ACMEIssuerDNS01ProviderCloudDNS_To_certmanager_ACMEIssuerDNS01ProviderCloudDNS(in *ACMEIssuerDNS01ProviderCloudDNS, out *certmanager.ACMEIssuerDNS01ProviderCloudDNS, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ACMEIssuerDNS01ProviderCloudDNS_To_certmanager_ACMEIssuerDNS01ProviderCloudDNS(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.ServiceAccount, &out.ServiceAccountSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ACMEIssuerDNS01ProviderCloudDNS_To_v1alpha1_ACMEIssuerDNS01ProviderCloudDNS converts the internal ACMEIssuerDNS01ProviderCloudDNS
+// structure into the v1alpha1 representation.
+func Convert_certmanager_ACMEIssuerDNS01ProviderCloudDNS_To_v1alpha1_ACMEIssuerDNS01ProviderCloudDNS(in *certmanager.ACMEIssuerDNS01ProviderCloudDNS, out *ACMEIssuerDNS01ProviderCloudDNS, s conversion.Scope) error {
+	if err := autoConvert_certmanager_ACMEIssuerDNS01ProviderCloudDNS_To_v1alpha1_ACMEIssuerDNS01ProviderCloudDNS(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(&in.ServiceAccountSecretRef, &out.ServiceAccount, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ACMEIssuerDNS01ProviderCloudflare_To_certmanager_ACMEIssuerDNS01ProviderCloudflare converts the v1alpha1 ACMEIssuerDNS01ProviderCloudflare
+// structure into the internal representation.
+func Convert_v1alpha1_ACMEIssuerDNS01ProviderCloudflare_To_certmanager_ACMEIssuerDNS01ProviderCloudflare(in *ACMEIssuerDNS01ProviderCloudflare, out *certmanager.ACMEIssuerDNS01ProviderCloudflare, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ACMEIssuerDNS01ProviderCloudflare_To_certmanager_ACMEIssuerDNS01ProviderCloudflare(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.APIKey, &out.APIKeySecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ACMEIssuerDNS01ProviderCloudflare_To_v1alpha1_ACMEIssuerDNS01ProviderCloudflare converts the internal ACMEIssuerDNS01ProviderCloudflare
+// structure into the v1alpha1 representation.
+func Convert_certmanager_ACMEIssuerDNS01ProviderCloudflare_To_v1alpha1_ACMEIssuerDNS01ProviderCloudflare(in *certmanager.ACMEIssuerDNS01ProviderCloudflare, out *ACMEIssuerDNS01ProviderCloudflare, s conversion.Scope) error {
+	if err := autoConvert_certmanager_ACMEIssuerDNS01ProviderCloudflare_To_v1alpha1_ACMEIssuerDNS01ProviderCloudflare(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(&in.APIKeySecretRef, &out.APIKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ACMEIssuerDNS01ProviderDigitalOcean_To_certmanager_ACMEIssuerDNS01ProviderDigitalOcean converts the v1alpha1 ACMEIssuerDNS01ProviderDigitalOcean
+// structure into the internal representation.
+func Convert_v1alpha1_ACMEIssuerDNS01ProviderDigitalOcean_To_certmanager_ACMEIssuerDNS01ProviderDigitalOcean(in *ACMEIssuerDNS01ProviderDigitalOcean, out *certmanager.ACMEIssuerDNS01ProviderDigitalOcean, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ACMEIssuerDNS01ProviderDigitalOcean_To_certmanager_ACMEIssuerDNS01ProviderDigitalOcean(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.Token, &out.TokenSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ACMEIssuerDNS01ProviderDigitalOcean_To_v1alpha1_ACMEIssuerDNS01ProviderDigitalOcean converts the internal ACMEIssuerDNS01ProviderDigitalOcean
+// structure into the v1alpha1 representation.
+func Convert_certmanager_ACMEIssuerDNS01ProviderDigitalOcean_To_v1alpha1_ACMEIssuerDNS01ProviderDigitalOcean(in *certmanager.ACMEIssuerDNS01ProviderDigitalOcean, out *ACMEIssuerDNS01ProviderDigitalOcean, s conversion.Scope) error {
+	if err := autoConvert_certmanager_ACMEIssuerDNS01ProviderDigitalOcean_To_v1alpha1_ACMEIssuerDNS01ProviderDigitalOcean(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(&in.TokenSecretRef, &out.Token, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ACMEIssuerDNS01ProviderRoute53_To_certmanager_ACMEIssuerDNS01ProviderRoute53 converts the v1alpha1 ACMEIssuerDNS01ProviderRoute53
+// structure into the internal representation.
+func Convert_v1alpha1_ACMEIssuerDNS01ProviderRoute53_To_certmanager_ACMEIssuerDNS01ProviderRoute53(in *ACMEIssuerDNS01ProviderRoute53, out *certmanager.ACMEIssuerDNS01ProviderRoute53, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ACMEIssuerDNS01ProviderRoute53_To_certmanager_ACMEIssuerDNS01ProviderRoute53(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKeySecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ACMEIssuerDNS01ProviderRoute53_To_v1alpha1_ACMEIssuerDNS01ProviderRoute53 converts the internal ACMEIssuerDNS01ProviderRoute53
+// structure into the v1alpha1 representation.
+func Convert_certmanager_ACMEIssuerDNS01ProviderRoute53_To_v1alpha1_ACMEIssuerDNS01ProviderRoute53(in *certmanager.ACMEIssuerDNS01ProviderRoute53, out *ACMEIssuerDNS01ProviderRoute53, s conversion.Scope) error {
+	if err := autoConvert_certmanager_ACMEIssuerDNS01ProviderRoute53_To_v1alpha1_ACMEIssuerDNS01ProviderRoute53(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(&in.SecretAccessKeySecretRef, &out.SecretAccessKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ACMEIssuerDNS01ProviderAzureDNS_To_certmanager_ACMEIssuerDNS01ProviderAzureDNS converts the v1alpha1 ACMEIssuerDNS01ProviderAzureDNS
+// structure into the internal representation.
+func Convert_v1alpha1_ACMEIssuerDNS01ProviderAzureDNS_To_certmanager_ACMEIssuerDNS01ProviderAzureDNS(in *ACMEIssuerDNS01ProviderAzureDNS, out *certmanager.ACMEIssuerDNS01ProviderAzureDNS, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ACMEIssuerDNS01ProviderAzureDNS_To_certmanager_ACMEIssuerDNS01ProviderAzureDNS(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.ClientSecret, &out.ClientSecretSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ACMEIssuerDNS01ProviderAzureDNS_To_v1alpha1_ACMEIssuerDNS01ProviderAzureDNS converts the internal ACMEIssuerDNS01ProviderAzureDNS
+// structure into the v1alpha1 representation.
+func Convert_certmanager_ACMEIssuerDNS01ProviderAzureDNS_To_v1alpha1_ACMEIssuerDNS01ProviderAzureDNS(in *certmanager.ACMEIssuerDNS01ProviderAzureDNS, out *ACMEIssuerDNS01ProviderAzureDNS, s conversion.Scope) error {
+	if err := autoConvert_certmanager_ACMEIssuerDNS01ProviderAzureDNS_To_v1alpha1_ACMEIssuerDNS01ProviderAzureDNS(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(&in.ClientSecretSecretRef, &out.ClientSecret, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ACMEIssuerDNS01ProviderAcmeDNS_To_certmanager_ACMEIssuerDNS01ProviderAcmeDNS converts the v1alpha1 ACMEIssuerDNS01ProviderAcmeDNS
+// structure into the internal representation.
+func Convert_v1alpha1_ACMEIssuerDNS01ProviderAcmeDNS_To_certmanager_ACMEIssuerDNS01ProviderAcmeDNS(in *ACMEIssuerDNS01ProviderAcmeDNS, out *certmanager.ACMEIssuerDNS01ProviderAcmeDNS, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ACMEIssuerDNS01ProviderAcmeDNS_To_certmanager_ACMEIssuerDNS01ProviderAcmeDNS(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.AccountSecret, &out.AccountSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ACMEIssuerDNS01ProviderAcmeDNS_To_v1alpha1_ACMEIssuerDNS01ProviderAcmeDNS converts the internal ACMEIssuerDNS01ProviderAcmeDNS
+// structure into the v1alpha1 representation.
+func Convert_certmanager_ACMEIssuerDNS01ProviderAcmeDNS_To_v1alpha1_ACMEIssuerDNS01ProviderAcmeDNS(in *certmanager.ACMEIssuerDNS01ProviderAcmeDNS, out *ACMEIssuerDNS01ProviderAcmeDNS, s conversion.Scope) error {
+	if err := autoConvert_certmanager_ACMEIssuerDNS01ProviderAcmeDNS_To_v1alpha1_ACMEIssuerDNS01ProviderAcmeDNS(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(&in.AccountSecretRef, &out.AccountSecret, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ACMEIssuerDNS01ProviderRFC2136_To_certmanager_ACMEIssuerDNS01ProviderRFC2136 converts the v1alpha1 ACMEIssuerDNS01ProviderRFC2136
+// structure into the internal representation.
+func Convert_v1alpha1_ACMEIssuerDNS01ProviderRFC2136_To_certmanager_ACMEIssuerDNS01ProviderRFC2136(in *ACMEIssuerDNS01ProviderRFC2136, out *certmanager.ACMEIssuerDNS01ProviderRFC2136, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ACMEIssuerDNS01ProviderRFC2136_To_certmanager_ACMEIssuerDNS01ProviderRFC2136(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretKeySelector_To_certmanager_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecretSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ACMEIssuerDNS01ProviderRFC2136_To_v1alpha1_ACMEIssuerDNS01ProviderRFC2136 converts the internal ACMEIssuerDNS01ProviderRFC2136
+// structure into the v1alpha1 representation.
+func Convert_certmanager_ACMEIssuerDNS01ProviderRFC2136_To_v1alpha1_ACMEIssuerDNS01ProviderRFC2136(in *certmanager.ACMEIssuerDNS01ProviderRFC2136, out *ACMEIssuerDNS01ProviderRFC2136, s conversion.Scope) error {
+	if err := autoConvert_certmanager_ACMEIssuerDNS01ProviderRFC2136_To_v1alpha1_ACMEIssuerDNS01ProviderRFC2136(in, out, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SecretKeySelector_To_v1alpha1_SecretKeySelector(&in.TSIGSecretSecretRef, &out.TSIGSecret, s); err != nil {
+		return err
+	}
+	return nil
+}
@@ -19,10 +19,41 @@ package v1alpha1
 const (
 	AltNamesAnnotationKey   = "certmanager.k8s.io/alt-names"
 	IPSANAnnotationKey      = "certmanager.k8s.io/ip-sans"
+	URISANAnnotationKey     = "certmanager.k8s.io/uri-sans"
 	CommonNameAnnotationKey = "certmanager.k8s.io/common-name"
 	IssuerNameAnnotationKey = "certmanager.k8s.io/issuer-name"
 	IssuerKindAnnotationKey = "certmanager.k8s.io/issuer-kind"
 	CertificateNameKey      = "certmanager.k8s.io/certificate-name"
+
+	// CRPrivateKeyAnnotationKey is set on CertificateRequest resources created
+	// by the Certificate controller, and names the Secret holding the private
+	// key used to generate the request. It is required by issuers that need
+	// access to the private key itself, such as the SelfSigned issuer.
+	CRPrivateKeyAnnotationKey = "certmanager.k8s.io/private-key-secret-name"
+)
+
+const (
+	// PKCS12SecretKey is the name of the data entry in Secret resources
+	// used to store PKCS#12 keystores.
+	PKCS12SecretKey = "keystore.p12"
+	// JKSSecretKey is the name of the data entry in Secret resources used to
+	// store JKS keystores.
+	JKSSecretKey = "keystore.jks"
+	// JKSTruststoreKey is the name of the data entry in Secret resources
+	// used to store JKS truststores containing the issuing CA.
+	JKSTruststoreKey = "truststore.jks"
+
+	// CertificateOutputFormatDERKey is the name of the data entry in Secret
+	// resources used to store the DER encoded private key.
+	CertificateOutputFormatDERKey = "key.der"
+	// CertificateOutputFormatCombinedPEMKey is the name of the data entry in
+	// Secret resources used to store the combined PEM encoded private key and
+	// certificate chain.
+	CertificateOutputFormatCombinedPEMKey = "tls-combined.pem"
+
+	// CRLSecretKey is the name of the data entry in Secret resources used to
+	// store the DER encoded certificate revocation list of a CA issuer.
+	CRLSecretKey = "ca.crl"
 )
 
 // ConditionStatus represents a condition's status.
@@ -59,10 +90,11 @@ type ObjectReference struct {
 }
 
 const (
-	ClusterIssuerKind = "ClusterIssuer"
-	IssuerKind        = "Issuer"
-	CertificateKind   = "Certificate"
-	OrderKind         = "Order"
+	ClusterIssuerKind      = "ClusterIssuer"
+	IssuerKind             = "Issuer"
+	CertificateKind        = "Certificate"
+	CertificateRequestKind = "CertificateRequest"
+	OrderKind              = "Order"
 )
 
 type SecretKeySelector struct {
@@ -186,6 +186,68 @@ type CAIssuer struct {
 	// SecretName is the name of the secret used to sign Certificates issued
 	// by this Issuer.
 	SecretName string `json:"secretName"`
+
+	// CRLDistributionPoints is a list of URLs from which a certificate
+	// revocation list for this CA can be retrieved. They are set as the CRL
+	// distribution points extension of every certificate signed by this
+	// Issuer.
+	// +optional
+	CRLDistributionPoints []string `json:"crlDistributionPoints,omitempty"`
+
+	// OCSPServers is a list of URLs of OCSP responders for this CA. They are
+	// set in the authority information access extension of every certificate
+	// signed by this Issuer.
+	// +optional
+	OCSPServers []string `json:"ocspServers,omitempty"`
+
+	// IssuingCertificateURLs is a list of URLs from which the certificate of
+	// this CA can be retrieved. They are set in the authority information
+	// access extension of every certificate signed by this Issuer.
+	// +optional
+	IssuingCertificateURLs []string `json:"issuingCertificateURLs,omitempty"`
+
+	// CRL configures a certificate revocation list to be maintained for this
+	// CA. If not set, no CRL will be generated.
+	// +optional
+	CRL *CACRLConfig `json:"crl,omitempty"`
+
+	// OCSP configures how responses are signed by the cert-manager OCSP
+	// responder for this CA. If not set, responses are signed with the CA's
+	// own private key.
+	// +optional
+	OCSP *CAOCSPConfig `json:"ocsp,omitempty"`
+}
+
+// CACRLConfig configures the certificate revocation list (CRL) maintained by
+// cert-manager for a CA issuer.
+type CACRLConfig struct {
+	// SecretName is the name of the Secret resource that the DER encoded CRL
+	// will be written to, under the 'ca.crl' key. The Secret is created in the
+	// same namespace as the Secret named by the Issuer's secretName.
+	SecretName string `json:"secretName"`
+
+	// UpdatePeriod is how often the CRL is regenerated, even if no further
+	// certificates have been revoked. The nextUpdate field of each CRL is set
+	// to twice this period. Defaults to 24 hours.
+	// +optional
+	UpdatePeriod *metav1.Duration `json:"updatePeriod,omitempty"`
+
+	// RevokeOnDelete will cause the current certificate of a Certificate
+	// resource using this Issuer to be revoked when the Certificate resource
+	// is deleted.
+	// +optional
+	RevokeOnDelete bool `json:"revokeOnDelete,omitempty"`
+}
+
+// CAOCSPConfig configures the signing of OCSP responses for a CA issuer.
+type CAOCSPConfig struct {
+	// ResponderSecretName is the name of a Secret resource containing a
+	// delegated OCSP signing certificate and private key, which is used to
+	// sign OCSP responses instead of the CA's private key. The certificate
+	// must be signed by this CA and have the 'ocsp signing' usage. The Secret
+	// must be in the same namespace as the Secret named by the Issuer's
+	// secretName.
+	ResponderSecretName string `json:"responderSecretName"`
 }
 
 // ACMEIssuer contains the specification for an ACME issuer
@@ -520,6 +582,50 @@ type IssuerStatus struct {
 
 	// +optional
 	ACME *ACMEIssuerStatus `json:"acme,omitempty"`
+
+	// +optional
+	CA *CAIssuerStatus `json:"ca,omitempty"`
+}
+
+// CAIssuerStatus contains the status of a CA issuer.
+type CAIssuerStatus struct {
+	// RevokedCertificates is the list of certificates signed by this Issuer
+	// that have been revoked. They are included in the CRL for this Issuer,
+	// if one is configured.
+	// +optional
+	RevokedCertificates []RevokedCertificate `json:"revokedCertificates,omitempty"`
+
+	// IssuedCertificates is the list of certificates signed by this Issuer
+	// that have not yet expired. It is used by the OCSP responder to
+	// determine whether a certificate has been signed by cert-manager once
+	// the resources it was issued for have been deleted.
+	// +optional
+	IssuedCertificates []IssuedCertificate `json:"issuedCertificates,omitempty"`
+}
+
+// IssuedCertificate records a single certificate signed by an Issuer.
+type IssuedCertificate struct {
+	// SerialNumber is the serial number of the certificate, as a hexadecimal
+	// string.
+	SerialNumber string `json:"serialNumber"`
+
+	// NotAfter is the time at which the certificate expires.
+	NotAfter metav1.Time `json:"notAfter"`
+}
+
+// RevokedCertificate records the revocation of a single certificate.
+type RevokedCertificate struct {
+	// SerialNumber is the serial number of the revoked certificate, as a
+	// hexadecimal string.
+	SerialNumber string `json:"serialNumber"`
+
+	// RevocationTime is the time at which the certificate was revoked.
+	RevocationTime metav1.Time `json:"revocationTime"`
+
+	// NotAfter is the time at which the revoked certificate expires. Once it
+	// has passed, the certificate is removed from this list and the CRL.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
 }
 
 type ACMEIssuerStatus struct {
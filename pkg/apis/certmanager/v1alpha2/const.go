@@ -0,0 +1,64 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import "time"
+
+const (
+	// minimum permitted certificate duration by cert-manager
+	MinimumCertificateDuration = time.Hour
+
+	// default certificate duration if Issuer.spec.duration is not set
+	DefaultCertificateDuration = time.Hour * 24 * 90
+
+	// minimum certificate duration before certificate expiration
+	MinimumRenewBefore = time.Minute * 5
+
+	// Default duration before certificate expiration if  Issuer.spec.renewBefore is not set
+	DefaultRenewBefore = time.Hour * 24 * 30
+
+	// minimum permitted period between updates of a CA issuer's CRL
+	MinimumCRLUpdatePeriod = time.Minute * 5
+
+	// default period between updates of a CA issuer's CRL if
+	// Issuer.spec.ca.crl.updatePeriod is not set
+	DefaultCRLUpdatePeriod = time.Hour * 24
+)
+
+const (
+	// default private key size if Certificate.spec.keySize is not set and
+	// the rsa key algorithm is used
+	DefaultRSAKeySize = 2048
+
+	// default private key size if Certificate.spec.keySize is not set and
+	// the ecdsa key algorithm is used
+	DefaultECDSAKeySize = 256
+
+	// default path that the Vault AppRole auth backend is mounted on
+	DefaultVaultAppRolePath = "approle"
+
+	// default key in the Vault token Secret that holds the token
+	DefaultVaultTokenSecretKey = "token"
+)
+
+const (
+	ACMEFinalizer = "finalizer.acme.cert-manager.io"
+
+	// RevokeOnDeleteFinalizer is added to Certificates whose CA issuer revokes
+	// certificates on deletion, until the certificate has been revoked
+	RevokeOnDeleteFinalizer = "finalizer.revoke.cert-manager.io"
+)
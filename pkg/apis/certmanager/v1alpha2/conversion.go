@@ -0,0 +1,248 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+)
+
+func addConversionFuncs(scheme *runtime.Scheme) error {
+	// Add non-generated conversion functions
+	return scheme.AddConversionFuncs()
+}
+
+// The fields that were deprecated in v1alpha1 have been removed in v1alpha2.
+// As v1alpha1 is the storage version, the values of these fields are stored in
+// the DeprecatedFieldsAnnotationKey annotation when a resource is converted to
+// v1alpha2, and restored from it when converting back, so that resources can
+// be read, modified and written using the v1alpha2 API without losing them.
+// Resources that still make use of these fields should be migrated using
+// 'cmctl convert'.
+
+// Convert_certmanager_CertificateSpec_To_v1alpha2_CertificateSpec drops the
+// deprecated 'acme' field.
+func Convert_certmanager_CertificateSpec_To_v1alpha2_CertificateSpec(in *certmanager.CertificateSpec, out *CertificateSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateSpec_To_v1alpha2_CertificateSpec(in, out, s)
+}
+
+// Convert_certmanager_ACMEIssuer_To_v1alpha2_ACMEIssuer drops the deprecated
+// 'http01' and 'dns01' fields.
+func Convert_certmanager_ACMEIssuer_To_v1alpha2_ACMEIssuer(in *certmanager.ACMEIssuer, out *ACMEIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_ACMEIssuer_To_v1alpha2_ACMEIssuer(in, out, s)
+}
+
+// Convert_certmanager_OrderSpec_To_v1alpha2_OrderSpec drops the deprecated
+// 'config' field.
+func Convert_certmanager_OrderSpec_To_v1alpha2_OrderSpec(in *certmanager.OrderSpec, out *OrderSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_OrderSpec_To_v1alpha2_OrderSpec(in, out, s)
+}
+
+// Convert_certmanager_ChallengeSpec_To_v1alpha2_ChallengeSpec drops the
+// deprecated 'config' field.
+func Convert_certmanager_ChallengeSpec_To_v1alpha2_ChallengeSpec(in *certmanager.ChallengeSpec, out *ChallengeSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_ChallengeSpec_To_v1alpha2_ChallengeSpec(in, out, s)
+}
+
+// deprecatedCertificateFields holds the Certificate fields removed in v1alpha2.
+type deprecatedCertificateFields struct {
+	ACME *certmanager.ACMECertificateConfig `json:"acme,omitempty"`
+}
+
+// deprecatedIssuerFields holds the Issuer and ClusterIssuer fields removed in
+// v1alpha2.
+type deprecatedIssuerFields struct {
+	HTTP01 *certmanager.ACMEIssuerHTTP01Config `json:"http01,omitempty"`
+	DNS01  *certmanager.ACMEIssuerDNS01Config  `json:"dns01,omitempty"`
+}
+
+// deprecatedOrderFields holds the Order fields removed in v1alpha2.
+type deprecatedOrderFields struct {
+	Config []certmanager.DomainSolverConfig `json:"config,omitempty"`
+}
+
+// deprecatedChallengeFields holds the Challenge fields removed in v1alpha2.
+type deprecatedChallengeFields struct {
+	Config *certmanager.SolverConfig `json:"config,omitempty"`
+}
+
+func Convert_certmanager_Certificate_To_v1alpha2_Certificate(in *certmanager.Certificate, out *Certificate, s conversion.Scope) error {
+	if err := autoConvert_certmanager_Certificate_To_v1alpha2_Certificate(in, out, s); err != nil {
+		return err
+	}
+	if in.Spec.ACME == nil {
+		return nil
+	}
+	return storeDeprecatedFields(&out.ObjectMeta, deprecatedCertificateFields{ACME: in.Spec.ACME})
+}
+
+func Convert_v1alpha2_Certificate_To_certmanager_Certificate(in *Certificate, out *certmanager.Certificate, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_Certificate_To_certmanager_Certificate(in, out, s); err != nil {
+		return err
+	}
+	fields := deprecatedCertificateFields{}
+	if err := restoreDeprecatedFields(&out.ObjectMeta, &fields); err != nil {
+		return err
+	}
+	out.Spec.ACME = fields.ACME
+	return nil
+}
+
+func Convert_certmanager_Issuer_To_v1alpha2_Issuer(in *certmanager.Issuer, out *Issuer, s conversion.Scope) error {
+	if err := autoConvert_certmanager_Issuer_To_v1alpha2_Issuer(in, out, s); err != nil {
+		return err
+	}
+	return storeDeprecatedIssuerFields(&in.Spec, &out.ObjectMeta)
+}
+
+func Convert_v1alpha2_Issuer_To_certmanager_Issuer(in *Issuer, out *certmanager.Issuer, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_Issuer_To_certmanager_Issuer(in, out, s); err != nil {
+		return err
+	}
+	return restoreDeprecatedIssuerFields(&out.ObjectMeta, &out.Spec)
+}
+
+func Convert_certmanager_ClusterIssuer_To_v1alpha2_ClusterIssuer(in *certmanager.ClusterIssuer, out *ClusterIssuer, s conversion.Scope) error {
+	if err := autoConvert_certmanager_ClusterIssuer_To_v1alpha2_ClusterIssuer(in, out, s); err != nil {
+		return err
+	}
+	return storeDeprecatedIssuerFields(&in.Spec, &out.ObjectMeta)
+}
+
+func Convert_v1alpha2_ClusterIssuer_To_certmanager_ClusterIssuer(in *ClusterIssuer, out *certmanager.ClusterIssuer, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_ClusterIssuer_To_certmanager_ClusterIssuer(in, out, s); err != nil {
+		return err
+	}
+	return restoreDeprecatedIssuerFields(&out.ObjectMeta, &out.Spec)
+}
+
+func storeDeprecatedIssuerFields(spec *certmanager.IssuerSpec, meta *metav1.ObjectMeta) error {
+	if spec.ACME == nil || (spec.ACME.HTTP01 == nil && spec.ACME.DNS01 == nil) {
+		return nil
+	}
+	return storeDeprecatedFields(meta, deprecatedIssuerFields{
+		HTTP01: spec.ACME.HTTP01,
+		DNS01:  spec.ACME.DNS01,
+	})
+}
+
+func restoreDeprecatedIssuerFields(meta *metav1.ObjectMeta, spec *certmanager.IssuerSpec) error {
+	fields := deprecatedIssuerFields{}
+	if err := restoreDeprecatedFields(meta, &fields); err != nil {
+		return err
+	}
+	if fields.HTTP01 == nil && fields.DNS01 == nil {
+		return nil
+	}
+	if spec.ACME == nil {
+		return fmt.Errorf("%s annotation is set but spec.acme is not", DeprecatedFieldsAnnotationKey)
+	}
+	spec.ACME.HTTP01 = fields.HTTP01
+	spec.ACME.DNS01 = fields.DNS01
+	return nil
+}
+
+func Convert_certmanager_Order_To_v1alpha2_Order(in *certmanager.Order, out *Order, s conversion.Scope) error {
+	if err := autoConvert_certmanager_Order_To_v1alpha2_Order(in, out, s); err != nil {
+		return err
+	}
+	if len(in.Spec.Config) == 0 {
+		return nil
+	}
+	return storeDeprecatedFields(&out.ObjectMeta, deprecatedOrderFields{Config: in.Spec.Config})
+}
+
+func Convert_v1alpha2_Order_To_certmanager_Order(in *Order, out *certmanager.Order, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_Order_To_certmanager_Order(in, out, s); err != nil {
+		return err
+	}
+	fields := deprecatedOrderFields{}
+	if err := restoreDeprecatedFields(&out.ObjectMeta, &fields); err != nil {
+		return err
+	}
+	out.Spec.Config = fields.Config
+	return nil
+}
+
+func Convert_certmanager_Challenge_To_v1alpha2_Challenge(in *certmanager.Challenge, out *Challenge, s conversion.Scope) error {
+	if err := autoConvert_certmanager_Challenge_To_v1alpha2_Challenge(in, out, s); err != nil {
+		return err
+	}
+	if in.Spec.Config == nil {
+		return nil
+	}
+	return storeDeprecatedFields(&out.ObjectMeta, deprecatedChallengeFields{Config: in.Spec.Config})
+}
+
+func Convert_v1alpha2_Challenge_To_certmanager_Challenge(in *Challenge, out *certmanager.Challenge, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_Challenge_To_certmanager_Challenge(in, out, s); err != nil {
+		return err
+	}
+	fields := deprecatedChallengeFields{}
+	if err := restoreDeprecatedFields(&out.ObjectMeta, &fields); err != nil {
+		return err
+	}
+	out.Spec.Config = fields.Config
+	return nil
+}
+
+// storeDeprecatedFields JSON encodes fields into the deprecated fields
+// annotation on meta. The annotations map is copied, as it is shared with the
+// object being converted.
+func storeDeprecatedFields(meta *metav1.ObjectMeta, fields interface{}) error {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return fmt.Errorf("failed to encode deprecated fields: %v", err)
+	}
+	annotations := make(map[string]string, len(meta.Annotations)+1)
+	for k, v := range meta.Annotations {
+		annotations[k] = v
+	}
+	annotations[DeprecatedFieldsAnnotationKey] = string(data)
+	meta.Annotations = annotations
+	return nil
+}
+
+// restoreDeprecatedFields decodes the deprecated fields annotation on meta into
+// fields, if it is set, and removes the annotation. The annotations map is
+// copied, as it is shared with the object being converted.
+func restoreDeprecatedFields(meta *metav1.ObjectMeta, fields interface{}) error {
+	data, ok := meta.Annotations[DeprecatedFieldsAnnotationKey]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(data), fields); err != nil {
+		return fmt.Errorf("failed to decode %s annotation: %v", DeprecatedFieldsAnnotationKey, err)
+	}
+	annotations := make(map[string]string, len(meta.Annotations)-1)
+	for k, v := range meta.Annotations {
+		if k != DeprecatedFieldsAnnotationKey {
+			annotations[k] = v
+		}
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	meta.Annotations = annotations
+	return nil
+}
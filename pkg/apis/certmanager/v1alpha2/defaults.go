@@ -0,0 +1,72 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_CertificateSpec sets the values that cert-manager would
+// otherwise assume when the corresponding fields are not set.
+func SetDefaults_CertificateSpec(obj *CertificateSpec) {
+	if obj.IssuerRef.Kind == "" {
+		obj.IssuerRef.Kind = IssuerKind
+	}
+	if obj.KeyAlgorithm == "" {
+		obj.KeyAlgorithm = RSAKeyAlgorithm
+	}
+	if obj.KeySize == 0 {
+		switch obj.KeyAlgorithm {
+		case RSAKeyAlgorithm:
+			obj.KeySize = DefaultRSAKeySize
+		case ECDSAKeyAlgorithm:
+			obj.KeySize = DefaultECDSAKeySize
+		}
+	}
+	if obj.KeyEncoding == "" {
+		// ed25519 private keys can only be encoded using PKCS#8
+		if obj.KeyAlgorithm == Ed25519KeyAlgorithm {
+			obj.KeyEncoding = PKCS8
+		} else {
+			obj.KeyEncoding = PKCS1
+		}
+	}
+}
+
+// SetDefaults_VaultAuth sets the defaults for whichever Vault authentication
+// method is in use.
+func SetDefaults_VaultAuth(obj *VaultAuth) {
+	if obj.TokenSecretRef != nil && obj.TokenSecretRef.Key == "" {
+		obj.TokenSecretRef.Key = DefaultVaultTokenSecretKey
+	}
+	if obj.AppRole != nil && obj.AppRole.Path == "" {
+		obj.AppRole.Path = DefaultVaultAppRolePath
+	}
+}
+
+// SetDefaults_ACMEChallengeSolverHTTP01Ingress sets the type of the Service
+// created for HTTP01 challenge solver pods.
+func SetDefaults_ACMEChallengeSolverHTTP01Ingress(obj *ACMEChallengeSolverHTTP01Ingress) {
+	if obj.ServiceType == "" {
+		obj.ServiceType = corev1.ServiceTypeNodePort
+	}
+}
@@ -19,10 +19,17 @@ package validation
 import (
 	"fmt"
 	"net"
+	"net/mail"
+	"net/url"
+	"sort"
+	"strings"
 
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
 // Validation functions for cert-manager v1alpha1 Certificate types
@@ -47,12 +54,18 @@ func ValidateCertificateSpec(crt *v1alpha1.CertificateSpec, fldPath *field.Path)
 	default:
 		el = append(el, field.Invalid(issuerRefPath.Child("kind"), crt.IssuerRef.Kind, "must be one of Issuer or ClusterIssuer"))
 	}
-	if len(crt.CommonName) == 0 && len(crt.DNSNames) == 0 {
-		el = append(el, field.Required(fldPath.Child("dnsNames"), "at least one dnsName is required if commonName is not set"))
+	if len(crt.CommonName) == 0 && len(crt.DNSNames) == 0 && len(crt.URISANs) == 0 && len(crt.EmailSANs) == 0 {
+		el = append(el, field.Required(fldPath.Child("dnsNames"), "at least one dnsName, uriSAN or emailSAN is required if commonName is not set"))
 	}
 	if len(crt.IPAddresses) > 0 {
 		el = append(el, validateIPAddresses(crt, fldPath)...)
 	}
+	if len(crt.URISANs) > 0 {
+		el = append(el, validateURISANs(crt, fldPath)...)
+	}
+	if len(crt.EmailSANs) > 0 {
+		el = append(el, validateEmailSANs(crt, fldPath)...)
+	}
 	if crt.ACME != nil {
 		el = append(el, validateACMEConfigForAllDNSNames(crt, fldPath)...)
 		el = append(el, ValidateACMECertificateConfig(crt.ACME, fldPath.Child("acme"))...)
@@ -70,11 +83,57 @@ func ValidateCertificateSpec(crt *v1alpha1.CertificateSpec, fldPath *field.Path)
 		if crt.KeySize > 0 && crt.KeySize != 256 && crt.KeySize != 384 && crt.KeySize != 521 {
 			el = append(el, field.NotSupported(fldPath.Child("keySize"), crt.KeySize, []string{"256", "384", "521"}))
 		}
+	case v1alpha1.Ed25519KeyAlgorithm:
+		if crt.KeySize > 0 {
+			el = append(el, field.Invalid(fldPath.Child("keySize"), crt.KeySize, "must not be set for ed25519 keyAlgorithm"))
+		}
+	default:
+		el = append(el, field.Invalid(fldPath.Child("keyAlgorithm"), crt.KeyAlgorithm, "must be either empty or one of rsa, ecdsa or ed25519"))
+	}
+
+	switch crt.KeyEncoding {
+	case v1alpha1.KeyEncoding(""), v1alpha1.PKCS1, v1alpha1.PKCS8:
 	default:
-		el = append(el, field.Invalid(fldPath.Child("keyAlgorithm"), crt.KeyAlgorithm, "must be either empty or one of rsa or ecdsa"))
+		el = append(el, field.Invalid(fldPath.Child("keyEncoding"), crt.KeyEncoding, "must be either empty or one of pkcs1 or pkcs8"))
+	}
+
+	if crt.PrivateKey != nil {
+		switch crt.PrivateKey.RotationPolicy {
+		case v1alpha1.PrivateKeyRotationPolicy(""), v1alpha1.RotationPolicyNever, v1alpha1.RotationPolicyAlways:
+		default:
+			el = append(el, field.Invalid(fldPath.Child("privateKey", "rotationPolicy"), crt.PrivateKey.RotationPolicy, "must be either empty or one of Never or Always"))
+		}
+		if crt.PrivateKey.SecretRef != nil {
+			if crt.PrivateKey.SecretRef.Name == "" {
+				el = append(el, field.Required(fldPath.Child("privateKey", "secretRef", "name"), "must be specified"))
+			}
+			if crt.PrivateKey.RotationPolicy == v1alpha1.RotationPolicyAlways {
+				el = append(el, field.Forbidden(fldPath.Child("privateKey", "rotationPolicy"), "may not be Always when a secretRef is specified"))
+			}
+		}
+	}
+
+	if len(crt.Usages) > 0 {
+		el = append(el, validateUsages(crt.Usages, fldPath.Child("usages"))...)
 	}
 
-	if crt.Duration != nil || crt.RenewBefore != nil {
+	if crt.MaxPathLen != nil || crt.NameConstraints != nil {
+		el = append(el, validateCAConstraints(crt.IsCA, crt.MaxPathLen, crt.NameConstraints, fldPath)...)
+	}
+
+	if crt.Keystores != nil {
+		el = append(el, validateKeystores(crt.Keystores, fldPath.Child("keystores"))...)
+	}
+
+	if len(crt.AdditionalOutputFormats) > 0 {
+		el = append(el, validateAdditionalOutputFormats(crt.AdditionalOutputFormats, fldPath.Child("additionalOutputFormats"))...)
+	}
+
+	if crt.SecretTemplate != nil {
+		el = append(el, validateSecretTemplate(crt.SecretTemplate, fldPath.Child("secretTemplate"))...)
+	}
+
+	if crt.Duration != nil || crt.RenewBefore != nil || crt.RenewBeforePercentage != nil {
 		el = append(el, ValidateDuration(crt, fldPath)...)
 	}
 
@@ -122,6 +181,165 @@ func validateIPAddresses(a *v1alpha1.CertificateSpec, fldPath *field.Path) field
 	return el
 }
 
+func validateURISANs(a *v1alpha1.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	if len(a.URISANs) <= 0 {
+		return nil
+	}
+	el := field.ErrorList{}
+	for i, d := range a.URISANs {
+		uri, err := url.Parse(d)
+		if err != nil {
+			el = append(el, field.Invalid(fldPath.Child("uriSANs").Index(i), d, fmt.Sprintf("invalid URI: %v", err)))
+			continue
+		}
+		if !uri.IsAbs() {
+			el = append(el, field.Invalid(fldPath.Child("uriSANs").Index(i), d, "URI must be absolute"))
+		}
+	}
+	return el
+}
+
+func validateEmailSANs(a *v1alpha1.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	if len(a.EmailSANs) <= 0 {
+		return nil
+	}
+	el := field.ErrorList{}
+	for i, d := range a.EmailSANs {
+		addr, err := mail.ParseAddress(d)
+		if err != nil || addr.Address != d {
+			el = append(el, field.Invalid(fldPath.Child("emailSANs").Index(i), d, "invalid email address"))
+		}
+	}
+	return el
+}
+
+func validateUsages(usages []v1alpha1.KeyUsage, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	for i, u := range usages {
+		_, isKU := pki.KeyUsageType(u)
+		_, isEKU := pki.ExtKeyUsageType(u)
+		if !isKU && !isEKU {
+			el = append(el, field.Invalid(fldPath.Index(i), u, "unknown keyusage"))
+		}
+	}
+	return el
+}
+
+// validateCAConstraints validates the maxPathLen and nameConstraints fields
+// of a Certificate or CertificateRequest, which may only be set on CAs.
+func validateCAConstraints(isCA bool, maxPathLen *int32, nc *v1alpha1.NameConstraints, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if maxPathLen != nil {
+		if !isCA {
+			el = append(el, field.Forbidden(fldPath.Child("maxPathLen"), "may only be set if isCA is true"))
+		}
+		if *maxPathLen < 0 {
+			el = append(el, field.Invalid(fldPath.Child("maxPathLen"), *maxPathLen, "cannot be less than zero"))
+		}
+	}
+	if nc != nil {
+		ncPath := fldPath.Child("nameConstraints")
+		if !isCA {
+			el = append(el, field.Forbidden(ncPath, "may only be set if isCA is true"))
+		}
+		if nc.Permitted == nil && nc.Excluded == nil {
+			el = append(el, field.Required(ncPath, "at least one of permitted or excluded must be specified"))
+		}
+		if nc.Permitted != nil {
+			el = append(el, validateNameConstraintItem(nc.Permitted, ncPath.Child("permitted"))...)
+		}
+		if nc.Excluded != nil {
+			el = append(el, validateNameConstraintItem(nc.Excluded, ncPath.Child("excluded"))...)
+		}
+	}
+	return el
+}
+
+func validateNameConstraintItem(item *v1alpha1.NameConstraintItem, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	for i, d := range item.DNSDomains {
+		if d == "" {
+			el = append(el, field.Invalid(fldPath.Child("dnsDomains").Index(i), d, "must not be empty"))
+		}
+	}
+	for i, r := range item.IPRanges {
+		if _, _, err := net.ParseCIDR(r); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("ipRanges").Index(i), r, "invalid CIDR"))
+		}
+	}
+	for i, e := range item.EmailAddresses {
+		if e == "" {
+			el = append(el, field.Invalid(fldPath.Child("emailAddresses").Index(i), e, "must not be empty"))
+		}
+	}
+	for i, d := range item.URIDomains {
+		if d == "" {
+			el = append(el, field.Invalid(fldPath.Child("uriDomains").Index(i), d, "must not be empty"))
+		}
+	}
+	return el
+}
+
+func validateKeystores(ks *v1alpha1.CertificateKeystores, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if ks.JKS != nil && ks.JKS.Create {
+		el = append(el, ValidateSecretKeySelector(&ks.JKS.PasswordSecretRef, fldPath.Child("jks", "passwordSecretRef"))...)
+	}
+	if ks.PKCS12 != nil && ks.PKCS12.Create {
+		el = append(el, ValidateSecretKeySelector(&ks.PKCS12.PasswordSecretRef, fldPath.Child("pkcs12", "passwordSecretRef"))...)
+	}
+	return el
+}
+
+func validateAdditionalOutputFormats(formats []v1alpha1.CertificateAdditionalOutputFormat, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	seen := make(map[v1alpha1.CertificateOutputFormatType]bool)
+	for i, f := range formats {
+		switch f.Type {
+		case v1alpha1.CertificateOutputFormatDER, v1alpha1.CertificateOutputFormatCombinedPEM:
+		default:
+			el = append(el, field.NotSupported(fldPath.Index(i).Child("type"), f.Type, []string{string(v1alpha1.CertificateOutputFormatDER), string(v1alpha1.CertificateOutputFormatCombinedPEM)}))
+			continue
+		}
+		if seen[f.Type] {
+			el = append(el, field.Duplicate(fldPath.Index(i).Child("type"), f.Type))
+		}
+		seen[f.Type] = true
+	}
+	return el
+}
+
+// certManagerKeyPrefix is the prefix of labels and annotations managed by
+// cert-manager on a Certificate's Secret, which may not be set using the
+// secretTemplate.
+const certManagerKeyPrefix = "certmanager.k8s.io/"
+
+func validateSecretTemplate(tmpl *v1alpha1.CertificateSecretTemplate, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	el = append(el, apivalidation.ValidateAnnotations(tmpl.Annotations, fldPath.Child("annotations"))...)
+	el = append(el, metav1validation.ValidateLabels(tmpl.Labels, fldPath.Child("labels"))...)
+	for _, k := range sortedKeys(tmpl.Annotations) {
+		if strings.HasPrefix(k, certManagerKeyPrefix) {
+			el = append(el, field.Invalid(fldPath.Child("annotations"), k, fmt.Sprintf("annotations with the %q prefix are managed by cert-manager", certManagerKeyPrefix)))
+		}
+	}
+	for _, k := range sortedKeys(tmpl.Labels) {
+		if strings.HasPrefix(k, certManagerKeyPrefix) {
+			el = append(el, field.Invalid(fldPath.Child("labels"), k, fmt.Sprintf("labels with the %q prefix are managed by cert-manager", certManagerKeyPrefix)))
+		}
+	}
+	return el
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func ValidateACMECertificateConfig(a *v1alpha1.ACMECertificateConfig, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	for i, cfg := range a.Config {
@@ -185,6 +403,15 @@ func ValidateDuration(crt *v1alpha1.CertificateSpec, fldPath *field.Path) field.
 	if duration < v1alpha1.MinimumCertificateDuration {
 		el = append(el, field.Invalid(fldPath.Child("duration"), duration, fmt.Sprintf("certificate duration must be greater than %s", v1alpha1.MinimumCertificateDuration)))
 	}
+	if crt.RenewBeforePercentage != nil {
+		if crt.RenewBefore != nil {
+			el = append(el, field.Forbidden(fldPath.Child("renewBeforePercentage"), "may not be specified when renewBefore is specified"))
+		}
+		if p := *crt.RenewBeforePercentage; p < 1 || p > 99 {
+			el = append(el, field.Invalid(fldPath.Child("renewBeforePercentage"), p, "certificate renewBeforePercentage must be between 1 and 99"))
+		}
+		return el
+	}
 	if renewBefore < v1alpha1.MinimumRenewBefore {
 		el = append(el, field.Invalid(fldPath.Child("renewBefore"), renewBefore, fmt.Sprintf("certificate renewBefore must be greater than %s", v1alpha1.MinimumRenewBefore)))
 	}
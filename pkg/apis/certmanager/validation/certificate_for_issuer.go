@@ -61,6 +61,14 @@ func ValidateCertificateForACMEIssuer(crt *v1alpha1.CertificateSpec, issuer *v1a
 		el = append(el, field.Invalid(specPath.Child("organization"), crt.Organization, "ACME does not support setting the organization name"))
 	}
 
+	if crt.Subject != nil {
+		el = append(el, field.Invalid(specPath.Child("subject"), crt.Subject, "ACME does not support setting the certificate subject"))
+	}
+
+	if crt.KeyAlgorithm == v1alpha1.Ed25519KeyAlgorithm {
+		el = append(el, field.Invalid(specPath.Child("keyAlgorithm"), crt.KeyAlgorithm, "ACME does not support ed25519 keys"))
+	}
+
 	if crt.Duration != nil {
 		el = append(el, field.Invalid(specPath.Child("duration"), crt.Duration, "ACME does not support certificate durations"))
 	}
@@ -69,6 +77,22 @@ func ValidateCertificateForACMEIssuer(crt *v1alpha1.CertificateSpec, issuer *v1a
 		el = append(el, field.Invalid(specPath.Child("ipAddresses"), crt.IPAddresses, "ACME does not support certificate ip addresses"))
 	}
 
+	if len(crt.URISANs) != 0 {
+		el = append(el, field.Invalid(specPath.Child("uriSANs"), crt.URISANs, "ACME does not support certificate uri SANs"))
+	}
+
+	if len(crt.EmailSANs) != 0 {
+		el = append(el, field.Invalid(specPath.Child("emailSANs"), crt.EmailSANs, "ACME does not support certificate email SANs"))
+	}
+
+	for i, u := range crt.Usages {
+		switch u {
+		case v1alpha1.UsageDigitalSignature, v1alpha1.UsageKeyEncipherment, v1alpha1.UsageServerAuth:
+		default:
+			el = append(el, field.Invalid(specPath.Child("usages").Index(i), u, "ACME only supports the 'digital signature', 'key encipherment' and 'server auth' usages"))
+		}
+	}
+
 	return el
 }
 
@@ -89,6 +113,14 @@ func ValidateCertificateForVaultIssuer(crt *v1alpha1.CertificateSpec, issuer *v1
 		el = append(el, field.Invalid(specPath.Child("organization"), crt.Organization, "Vault issuer does not currently support setting the organization name"))
 	}
 
+	if crt.Subject != nil {
+		el = append(el, field.Invalid(specPath.Child("subject"), crt.Subject, "Vault issuer does not currently support setting the certificate subject"))
+	}
+
+	if crt.KeyAlgorithm == v1alpha1.Ed25519KeyAlgorithm {
+		el = append(el, field.Invalid(specPath.Child("keyAlgorithm"), crt.KeyAlgorithm, "Vault issuer does not currently support ed25519 keys"))
+	}
+
 	return el
 }
 
@@ -101,5 +133,25 @@ func ValidateCertificateForSelfSignedIssuer(crt *v1alpha1.CertificateSpec, issue
 func ValidateCertificateForVenafiIssuer(crt *v1alpha1.CertificateSpec, issuer *v1alpha1.IssuerSpec, specPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
+	if len(crt.Usages) != 0 {
+		el = append(el, field.Invalid(specPath.Child("usages"), crt.Usages, "Venafi issuer does not currently support setting usages"))
+	}
+
+	if crt.Subject != nil {
+		el = append(el, field.Invalid(specPath.Child("subject"), crt.Subject, "Venafi issuer does not currently support setting the certificate subject"))
+	}
+
+	if crt.KeyAlgorithm == v1alpha1.Ed25519KeyAlgorithm {
+		el = append(el, field.Invalid(specPath.Child("keyAlgorithm"), crt.KeyAlgorithm, "Venafi issuer does not currently support ed25519 keys"))
+	}
+
+	if crt.MaxPathLen != nil {
+		el = append(el, field.Invalid(specPath.Child("maxPathLen"), *crt.MaxPathLen, "Venafi issuer does not currently support setting the maximum path length"))
+	}
+
+	if crt.NameConstraints != nil {
+		el = append(el, field.Invalid(specPath.Child("nameConstraints"), crt.NameConstraints, "Venafi issuer does not currently support name constraints"))
+	}
+
 	return el
 }
@@ -183,6 +183,163 @@ func TestValidateCertificateForIssuer(t *testing.T) {
 				field.Invalid(fldPath.Child("ipAddresses"), []string{"127.0.0.1"}, "ACME does not support certificate ip addresses"),
 			},
 		},
+		"acme certificate with uriSANs set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					URISANs:   []string{"spiffe://cluster.local/ns/sandbox/sa/foo"},
+					IssuerRef: validIssuerRef,
+					ACME: &v1alpha1.ACMECertificateConfig{
+						Config: []v1alpha1.DomainSolverConfig{
+							{
+								Domains: []string{"example.com"},
+								SolverConfig: v1alpha1.SolverConfig{
+									HTTP01: &v1alpha1.HTTP01SolverConfig{},
+								},
+							},
+						},
+					},
+				},
+			},
+			issuer: generate.Issuer(generate.IssuerConfig{
+				Name:      defaultTestIssuerName,
+				Namespace: defaultTestNamespace,
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("uriSANs"), []string{"spiffe://cluster.local/ns/sandbox/sa/foo"}, "ACME does not support certificate uri SANs"),
+			},
+		},
+		"acme certificate with server auth usages": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					Usages:    []v1alpha1.KeyUsage{v1alpha1.UsageDigitalSignature, v1alpha1.UsageKeyEncipherment, v1alpha1.UsageServerAuth},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			issuer: generate.Issuer(generate.IssuerConfig{
+				Name:      defaultTestIssuerName,
+				Namespace: defaultTestNamespace,
+			}),
+		},
+		"acme certificate with client auth usage": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					Usages:    []v1alpha1.KeyUsage{v1alpha1.UsageServerAuth, v1alpha1.UsageClientAuth},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			issuer: generate.Issuer(generate.IssuerConfig{
+				Name:      defaultTestIssuerName,
+				Namespace: defaultTestNamespace,
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usages").Index(1), v1alpha1.UsageClientAuth, "ACME only supports the 'digital signature', 'key encipherment' and 'server auth' usages"),
+			},
+		},
+		"venafi certificate with usages set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					Usages:    []v1alpha1.KeyUsage{v1alpha1.UsageClientAuth},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			issuer: &v1alpha1.Issuer{
+				Spec: v1alpha1.IssuerSpec{
+					IssuerConfig: v1alpha1.IssuerConfig{
+						Venafi: &v1alpha1.VenafiIssuer{},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usages"), []v1alpha1.KeyUsage{v1alpha1.UsageClientAuth}, "Venafi issuer does not currently support setting usages"),
+			},
+		},
+		"venafi certificate with subject set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					Subject:   &v1alpha1.X509Subject{Countries: []string{"GB"}},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			issuer: &v1alpha1.Issuer{
+				Spec: v1alpha1.IssuerSpec{
+					IssuerConfig: v1alpha1.IssuerConfig{
+						Venafi: &v1alpha1.VenafiIssuer{},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("subject"), &v1alpha1.X509Subject{Countries: []string{"GB"}}, "Venafi issuer does not currently support setting the certificate subject"),
+			},
+		},
+		"venafi certificate with maxPathLen and nameConstraints set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					IsCA:            true,
+					MaxPathLen:      int32Ptr(0),
+					NameConstraints: &v1alpha1.NameConstraints{Critical: true},
+					IssuerRef:       validIssuerRef,
+				},
+			},
+			issuer: &v1alpha1.Issuer{
+				Spec: v1alpha1.IssuerSpec{
+					IssuerConfig: v1alpha1.IssuerConfig{
+						Venafi: &v1alpha1.VenafiIssuer{},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("maxPathLen"), int32(0), "Venafi issuer does not currently support setting the maximum path length"),
+				field.Invalid(fldPath.Child("nameConstraints"), &v1alpha1.NameConstraints{Critical: true}, "Venafi issuer does not currently support name constraints"),
+			},
+		},
+		"acme certificate with subject set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "example.com",
+					Subject:    &v1alpha1.X509Subject{Countries: []string{"GB"}},
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			issuer: generate.Issuer(generate.IssuerConfig{
+				Name:      defaultTestIssuerName,
+				Namespace: defaultTestNamespace,
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("subject"), &v1alpha1.X509Subject{Countries: []string{"GB"}}, "ACME does not support setting the certificate subject"),
+			},
+		},
+		"acme certificate with emailSANs set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "example.com",
+					EmailSANs:  []string{"alice@example.com"},
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			issuer: generate.Issuer(generate.IssuerConfig{
+				Name:      defaultTestIssuerName,
+				Namespace: defaultTestNamespace,
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("emailSANs"), []string{"alice@example.com"}, "ACME does not support certificate email SANs"),
+			},
+		},
+		"acme certificate with ed25519 keyAlgorithm": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:   "example.com",
+					KeyAlgorithm: v1alpha1.Ed25519KeyAlgorithm,
+					IssuerRef:    validIssuerRef,
+				},
+			},
+			issuer: generate.Issuer(generate.IssuerConfig{
+				Name:      defaultTestIssuerName,
+				Namespace: defaultTestNamespace,
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keyAlgorithm"), v1alpha1.Ed25519KeyAlgorithm, "ACME does not support ed25519 keys"),
+			},
+		},
 		"acme certificate with renewBefore set": {
 			crt: &v1alpha1.Certificate{
 				Spec: v1alpha1.CertificateSpec{
@@ -39,6 +39,10 @@ func strPtr(s string) *string {
 	return &s
 }
 
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
 func TestValidateCertificate(t *testing.T) {
 	fldPath := field.NewPath("spec")
 	scenarios := map[string]struct {
@@ -121,7 +125,7 @@ func TestValidateCertificate(t *testing.T) {
 				},
 			},
 			errs: []*field.Error{
-				field.Required(fldPath.Child("dnsNames"), "at least one dnsName is required if commonName is not set"),
+				field.Required(fldPath.Child("dnsNames"), "at least one dnsName, uriSAN or emailSAN is required if commonName is not set"),
 			},
 		},
 		"certificate with no issuerRef": {
@@ -369,7 +373,221 @@ func TestValidateCertificate(t *testing.T) {
 				},
 			},
 			errs: []*field.Error{
-				field.Invalid(fldPath.Child("keyAlgorithm"), v1alpha1.KeyAlgorithm("blah"), "must be either empty or one of rsa or ecdsa"),
+				field.Invalid(fldPath.Child("keyAlgorithm"), v1alpha1.KeyAlgorithm("blah"), "must be either empty or one of rsa, ecdsa or ed25519"),
+			},
+		},
+		"valid certificate with pkcs8 keyEncoding": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:  "testcn",
+					SecretName:  "abc",
+					IssuerRef:   validIssuerRef,
+					KeyEncoding: v1alpha1.PKCS8,
+				},
+			},
+		},
+		"certificate with invalid keyEncoding": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:  "testcn",
+					SecretName:  "abc",
+					IssuerRef:   validIssuerRef,
+					KeyEncoding: v1alpha1.KeyEncoding("blah"),
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keyEncoding"), v1alpha1.KeyEncoding("blah"), "must be either empty or one of pkcs1 or pkcs8"),
+			},
+		},
+		"valid certificate with Always privateKey rotationPolicy": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &v1alpha1.CertificatePrivateKey{
+						RotationPolicy: v1alpha1.RotationPolicyAlways,
+					},
+				},
+			},
+		},
+		"certificate with invalid privateKey rotationPolicy": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &v1alpha1.CertificatePrivateKey{
+						RotationPolicy: v1alpha1.PrivateKeyRotationPolicy("blah"),
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "rotationPolicy"), v1alpha1.PrivateKeyRotationPolicy("blah"), "must be either empty or one of Never or Always"),
+			},
+		},
+		"valid certificate with a provided private key": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &v1alpha1.CertificatePrivateKey{
+						SecretRef: &v1alpha1.SecretKeySelector{
+							LocalObjectReference: v1alpha1.LocalObjectReference{Name: "escrowed-key"},
+						},
+					},
+				},
+			},
+		},
+		"certificate with a provided private key and Always rotationPolicy": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &v1alpha1.CertificatePrivateKey{
+						RotationPolicy: v1alpha1.RotationPolicyAlways,
+						SecretRef:      &v1alpha1.SecretKeySelector{Key: "key.pem"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("privateKey", "secretRef", "name"), "must be specified"),
+				field.Forbidden(fldPath.Child("privateKey", "rotationPolicy"), "may not be Always when a secretRef is specified"),
+			},
+		},
+		"valid certificate with additionalOutputFormats": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					AdditionalOutputFormats: []v1alpha1.CertificateAdditionalOutputFormat{
+						{Type: v1alpha1.CertificateOutputFormatDER},
+						{Type: v1alpha1.CertificateOutputFormatCombinedPEM},
+					},
+				},
+			},
+		},
+		"certificate with unknown additionalOutputFormat": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					AdditionalOutputFormats: []v1alpha1.CertificateAdditionalOutputFormat{
+						{Type: v1alpha1.CertificateOutputFormatType("PFX")},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("additionalOutputFormats").Index(0).Child("type"), v1alpha1.CertificateOutputFormatType("PFX"), []string{"DER", "CombinedPEM"}),
+			},
+		},
+		"certificate with duplicate additionalOutputFormats": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					AdditionalOutputFormats: []v1alpha1.CertificateAdditionalOutputFormat{
+						{Type: v1alpha1.CertificateOutputFormatDER},
+						{Type: v1alpha1.CertificateOutputFormatDER},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("additionalOutputFormats").Index(1).Child("type"), v1alpha1.CertificateOutputFormatDER),
+			},
+		},
+		"valid certificate with secretTemplate": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &v1alpha1.CertificateSecretTemplate{
+						Annotations: map[string]string{"example.com/backup": "true"},
+						Labels:      map[string]string{"app": "web"},
+					},
+				},
+			},
+		},
+		"certificate with invalid secretTemplate label": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &v1alpha1.CertificateSecretTemplate{
+						Labels: map[string]string{"app": "not a valid value"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "labels"), "not a valid value", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
+			},
+		},
+		"certificate with cert-manager annotation in secretTemplate": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &v1alpha1.CertificateSecretTemplate{
+						Annotations: map[string]string{v1alpha1.IssuerNameAnnotationKey: "other"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "annotations"), v1alpha1.IssuerNameAnnotationKey, `annotations with the "certmanager.k8s.io/" prefix are managed by cert-manager`),
+			},
+		},
+		"valid certificate with keystores": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &v1alpha1.CertificateKeystores{
+						JKS: &v1alpha1.JKSKeystore{
+							Create: true,
+							PasswordSecretRef: v1alpha1.SecretKeySelector{
+								LocalObjectReference: v1alpha1.LocalObjectReference{Name: "password"},
+								Key:                  "password",
+							},
+						},
+						PKCS12: &v1alpha1.PKCS12Keystore{
+							Create: false,
+						},
+					},
+				},
+			},
+		},
+		"certificate with keystores missing password secret reference": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &v1alpha1.CertificateKeystores{
+						JKS: &v1alpha1.JKSKeystore{
+							Create: true,
+							PasswordSecretRef: v1alpha1.SecretKeySelector{
+								LocalObjectReference: v1alpha1.LocalObjectReference{Name: "password"},
+							},
+						},
+						PKCS12: &v1alpha1.PKCS12Keystore{
+							Create: true,
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("keystores", "jks", "passwordSecretRef", "key"), "secret key is required"),
+				field.Required(fldPath.Child("keystores", "pkcs12", "passwordSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("keystores", "pkcs12", "passwordSecretRef", "key"), "secret key is required"),
 			},
 		},
 		"valid certificate with ipAddresses": {
@@ -395,6 +613,177 @@ func TestValidateCertificate(t *testing.T) {
 				field.Invalid(fldPath.Child("ipAddresses").Index(0), "blah", "invalid IP address"),
 			},
 		},
+		"valid certificate with only uriSANs": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					URISANs:    []string{"spiffe://cluster.local/ns/sandbox/sa/foo"},
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+		},
+		"certificate with relative uriSANs": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					URISANs:    []string{"/ns/sandbox/sa/foo"},
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("uriSANs").Index(0), "/ns/sandbox/sa/foo", "URI must be absolute"),
+			},
+		},
+		"valid certificate with only emailSANs": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					EmailSANs:  []string{"alice@example.com"},
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+		},
+		"certificate with invalid emailSANs": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					EmailSANs:  []string{"alice@example.com", "Alice <alice@example.com>", "blah"},
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("emailSANs").Index(1), "Alice <alice@example.com>", "invalid email address"),
+				field.Invalid(fldPath.Child("emailSANs").Index(2), "blah", "invalid email address"),
+			},
+		},
+		"valid certificate with ed25519 keyAlgorithm": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:   "testcn",
+					SecretName:   "abc",
+					IssuerRef:    validIssuerRef,
+					KeyAlgorithm: v1alpha1.Ed25519KeyAlgorithm,
+				},
+			},
+		},
+		"certificate with ed25519 keyAlgorithm and keySize": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:   "testcn",
+					SecretName:   "abc",
+					IssuerRef:    validIssuerRef,
+					KeyAlgorithm: v1alpha1.Ed25519KeyAlgorithm,
+					KeySize:      256,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keySize"), 256, "must not be set for ed25519 keyAlgorithm"),
+			},
+		},
+		"valid certificate with usages": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Usages:     []v1alpha1.KeyUsage{v1alpha1.UsageDigitalSignature, v1alpha1.UsageClientAuth},
+				},
+			},
+		},
+		"certificate with unknown usage": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Usages:     []v1alpha1.KeyUsage{v1alpha1.UsageClientAuth, "blah"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usages").Index(1), v1alpha1.KeyUsage("blah"), "unknown keyusage"),
+			},
+		},
+		"valid CA certificate with maxPathLen and nameConstraints": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					IsCA:       true,
+					MaxPathLen: int32Ptr(0),
+					NameConstraints: &v1alpha1.NameConstraints{
+						Critical: true,
+						Permitted: &v1alpha1.NameConstraintItem{
+							DNSDomains: []string{"team-a.example.com"},
+							IPRanges:   []string{"10.0.0.0/8"},
+						},
+						Excluded: &v1alpha1.NameConstraintItem{
+							DNSDomains: []string{"secret.team-a.example.com"},
+						},
+					},
+				},
+			},
+		},
+		"certificate with maxPathLen and nameConstraints that is not a CA": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					MaxPathLen: int32Ptr(-1),
+					NameConstraints: &v1alpha1.NameConstraints{
+						Permitted: &v1alpha1.NameConstraintItem{
+							DNSDomains: []string{"team-a.example.com"},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("maxPathLen"), "may only be set if isCA is true"),
+				field.Invalid(fldPath.Child("maxPathLen"), int32(-1), "cannot be less than zero"),
+				field.Forbidden(fldPath.Child("nameConstraints"), "may only be set if isCA is true"),
+			},
+		},
+		"CA certificate with invalid nameConstraints": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					IsCA:       true,
+					NameConstraints: &v1alpha1.NameConstraints{
+						Excluded: &v1alpha1.NameConstraintItem{
+							DNSDomains:     []string{""},
+							IPRanges:       []string{"10.0.0.1"},
+							EmailAddresses: []string{""},
+							URIDomains:     []string{""},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("nameConstraints", "excluded", "dnsDomains").Index(0), "", "must not be empty"),
+				field.Invalid(fldPath.Child("nameConstraints", "excluded", "ipRanges").Index(0), "10.0.0.1", "invalid CIDR"),
+				field.Invalid(fldPath.Child("nameConstraints", "excluded", "emailAddresses").Index(0), "", "must not be empty"),
+				field.Invalid(fldPath.Child("nameConstraints", "excluded", "uriDomains").Index(0), "", "must not be empty"),
+			},
+		},
+		"CA certificate with empty nameConstraints": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:      "testcn",
+					SecretName:      "abc",
+					IssuerRef:       validIssuerRef,
+					IsCA:            true,
+					NameConstraints: &v1alpha1.NameConstraints{},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("nameConstraints"), "at least one of permitted or excluded must be specified"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -687,6 +1076,40 @@ func TestValidateDuration(t *testing.T) {
 			},
 			errs: []*field.Error{field.Invalid(fldPath.Child("duration"), usefulDurations["half hour"].Duration, fmt.Sprintf("certificate duration must be greater than %s", v1alpha1.MinimumCertificateDuration))},
 		},
+		"valid renewBeforePercentage with a duration shorter than the default renewBefore": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					Duration:              usefulDurations["one hour"],
+					RenewBeforePercentage: int32Ptr(33),
+					CommonName:            "testcn",
+					SecretName:            "abc",
+					IssuerRef:             validIssuerRef,
+				},
+			},
+		},
+		"renewBeforePercentage and renewBefore both set": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					RenewBefore:           usefulDurations["one month"],
+					RenewBeforePercentage: int32Ptr(33),
+					CommonName:            "testcn",
+					SecretName:            "abc",
+					IssuerRef:             validIssuerRef,
+				},
+			},
+			errs: []*field.Error{field.Forbidden(fldPath.Child("renewBeforePercentage"), "may not be specified when renewBefore is specified")},
+		},
+		"renewBeforePercentage out of range": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					RenewBeforePercentage: int32Ptr(100),
+					CommonName:            "testcn",
+					SecretName:            "abc",
+					IssuerRef:             validIssuerRef,
+				},
+			},
+			errs: []*field.Error{field.Invalid(fldPath.Child("renewBeforePercentage"), int32(100), "certificate renewBeforePercentage must be between 1 and 99")},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
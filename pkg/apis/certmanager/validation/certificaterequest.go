@@ -0,0 +1,55 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// Validation functions for cert-manager v1alpha1 CertificateRequest types
+
+func ValidateCertificateRequest(cr *v1alpha1.CertificateRequest) field.ErrorList {
+	allErrs := ValidateCertificateRequestSpec(&cr.Spec, field.NewPath("spec"))
+	return allErrs
+}
+
+func ValidateCertificateRequestSpec(crSpec *v1alpha1.CertificateRequestSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if crSpec.IssuerRef.Name == "" {
+		el = append(el, field.Required(fldPath.Child("issuerRef", "name"), "must be specified"))
+	}
+	if len(crSpec.CSRPEM) == 0 {
+		el = append(el, field.Required(fldPath.Child("csr"), "must be specified"))
+	} else if _, err := pki.DecodeX509CertificateRequestBytes(crSpec.CSRPEM); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("csr"), crSpec.CSRPEM, err.Error()))
+	}
+	if crSpec.Duration != nil && crSpec.Duration.Duration < v1alpha1.MinimumCertificateDuration {
+		el = append(el, field.Invalid(fldPath.Child("duration"), crSpec.Duration.Duration, fmt.Sprintf("certificate duration must be greater than %s", v1alpha1.MinimumCertificateDuration)))
+	}
+	if len(crSpec.Usages) > 0 {
+		el = append(el, validateUsages(crSpec.Usages, fldPath.Child("usages"))...)
+	}
+	if crSpec.MaxPathLen != nil || crSpec.NameConstraints != nil {
+		el = append(el, validateCAConstraints(crSpec.IsCA, crSpec.MaxPathLen, crSpec.NameConstraints, fldPath)...)
+	}
+	return el
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+func generateCSR(t *testing.T, commonName string) []byte {
+	sk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csr, err := pki.EncodeCSR(&x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: commonName},
+	}, sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})
+}
+
+func TestValidateCertificateRequest(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	csrPEM := generateCSR(t, "testcn")
+	badCSR := []byte("not a csr")
+
+	scenarios := map[string]struct {
+		cr   *v1alpha1.CertificateRequest
+		errs []*field.Error
+	}{
+		"valid basic certificate request": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    csrPEM,
+					IssuerRef: validIssuerRef,
+				},
+			},
+		},
+		"valid certificate request with external issuer kind": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM: csrPEM,
+					IssuerRef: v1alpha1.ObjectReference{
+						Name: "valid",
+						Kind: "ExternalIssuer",
+					},
+				},
+			},
+		},
+		"certificate request with no issuerRef": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM: csrPEM,
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("issuerRef", "name"), "must be specified"),
+			},
+		},
+		"certificate request with no csr": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					IssuerRef: validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("csr"), "must be specified"),
+			},
+		},
+		"certificate request with invalid csr": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    badCSR,
+					IssuerRef: validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("csr"), badCSR, "error decoding certificate request PEM block"),
+			},
+		},
+		"certificate request with too short duration": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    csrPEM,
+					IssuerRef: validIssuerRef,
+					Duration:  &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("duration"), time.Minute, fmt.Sprintf("certificate duration must be greater than %s", v1alpha1.MinimumCertificateDuration)),
+			},
+		},
+		"certificate request with unknown usage": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    csrPEM,
+					IssuerRef: validIssuerRef,
+					Usages:    []v1alpha1.KeyUsage{"blah"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usages").Index(0), v1alpha1.KeyUsage("blah"), "unknown keyusage"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateCertificateRequest(s.cr)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
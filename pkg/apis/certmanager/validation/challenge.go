@@ -0,0 +1,77 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+// Validation functions for cert-manager v1alpha1 Challenge types
+
+var supportedChallengeTypes = []string{"http-01", "dns-01"}
+
+func ValidateChallenge(ch *v1alpha1.Challenge) field.ErrorList {
+	allErrs := ValidateChallengeSpec(&ch.Spec, field.NewPath("spec"))
+	return allErrs
+}
+
+// ValidateChallengeUpdate validates an updated Challenge. The spec of a
+// Challenge cannot be changed once it has been created.
+func ValidateChallengeUpdate(oldCh, newCh *v1alpha1.Challenge) field.ErrorList {
+	allErrs := ValidateChallenge(newCh)
+	if !apiequality.Semantic.DeepEqual(oldCh.Spec, newCh.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "field is immutable"))
+	}
+	return allErrs
+}
+
+func ValidateChallengeSpec(spec *v1alpha1.ChallengeSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if spec.AuthzURL == "" {
+		el = append(el, field.Required(fldPath.Child("authzURL"), "must be specified"))
+	}
+	switch spec.Type {
+	case "":
+		el = append(el, field.Required(fldPath.Child("type"), "must be specified"))
+	case "http-01", "dns-01":
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("type"), spec.Type, supportedChallengeTypes))
+	}
+	if spec.URL == "" {
+		el = append(el, field.Required(fldPath.Child("url"), "must be specified"))
+	}
+	if spec.DNSName == "" {
+		el = append(el, field.Required(fldPath.Child("dnsName"), "must be specified"))
+	}
+	if spec.Token == "" {
+		el = append(el, field.Required(fldPath.Child("token"), "must be specified"))
+	}
+	if spec.Key == "" {
+		el = append(el, field.Required(fldPath.Child("key"), "must be specified"))
+	}
+	switch {
+	case spec.Solver == nil && spec.Config == nil:
+		el = append(el, field.Required(fldPath.Child("solver"), "one of solver or config must be specified"))
+	case spec.Solver != nil && spec.Config != nil:
+		el = append(el, field.Forbidden(fldPath.Child("config"), "may not be specified when solver is specified"))
+	}
+	el = append(el, validateIssuerRef(&spec.IssuerRef, fldPath.Child("issuerRef"))...)
+	return el
+}
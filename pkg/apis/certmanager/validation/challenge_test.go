@@ -0,0 +1,125 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+func validChallengeSpec() v1alpha1.ChallengeSpec {
+	return v1alpha1.ChallengeSpec{
+		AuthzURL:  "https://acme/authz",
+		Type:      "http-01",
+		URL:       "https://acme/challenge",
+		DNSName:   "example.com",
+		Token:     "token",
+		Key:       "key",
+		Solver:    &v1alpha1.ACMEChallengeSolver{HTTP01: &v1alpha1.ACMEChallengeSolverHTTP01{}},
+		IssuerRef: validIssuerRef,
+	}
+}
+
+func TestValidateChallenge(t *testing.T) {
+	fldPath := field.NewPath("spec")
+
+	scenarios := map[string]struct {
+		spec func(*v1alpha1.ChallengeSpec)
+		errs []*field.Error
+	}{
+		"valid challenge": {
+			spec: func(*v1alpha1.ChallengeSpec) {},
+		},
+		"valid challenge using deprecated config": {
+			spec: func(s *v1alpha1.ChallengeSpec) {
+				s.Solver = nil
+				s.Config = &v1alpha1.SolverConfig{HTTP01: &v1alpha1.HTTP01SolverConfig{}}
+			},
+		},
+		"challenge with missing fields": {
+			spec: func(s *v1alpha1.ChallengeSpec) {
+				*s = v1alpha1.ChallengeSpec{}
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("authzURL"), "must be specified"),
+				field.Required(fldPath.Child("type"), "must be specified"),
+				field.Required(fldPath.Child("url"), "must be specified"),
+				field.Required(fldPath.Child("dnsName"), "must be specified"),
+				field.Required(fldPath.Child("token"), "must be specified"),
+				field.Required(fldPath.Child("key"), "must be specified"),
+				field.Required(fldPath.Child("solver"), "one of solver or config must be specified"),
+				field.Required(fldPath.Child("issuerRef", "name"), "must be specified"),
+			},
+		},
+		"challenge with unsupported type": {
+			spec: func(s *v1alpha1.ChallengeSpec) {
+				s.Type = "tls-alpn-01"
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("type"), "tls-alpn-01", []string{"http-01", "dns-01"}),
+			},
+		},
+		"challenge with both solver and config": {
+			spec: func(s *v1alpha1.ChallengeSpec) {
+				s.Config = &v1alpha1.SolverConfig{HTTP01: &v1alpha1.HTTP01SolverConfig{}}
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("config"), "may not be specified when solver is specified"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			ch := &v1alpha1.Challenge{Spec: validChallengeSpec()}
+			s.spec(&ch.Spec)
+			errs := ValidateChallenge(ch)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateChallengeUpdate(t *testing.T) {
+	old := &v1alpha1.Challenge{Spec: validChallengeSpec()}
+
+	statusUpdate := old.DeepCopy()
+	statusUpdate.Status.Processing = true
+	statusUpdate.Status.State = v1alpha1.Pending
+	if errs := ValidateChallengeUpdate(old, statusUpdate); len(errs) != 0 {
+		t.Errorf("Expected no errors updating challenge status but got %v", errs)
+	}
+
+	specUpdate := old.DeepCopy()
+	specUpdate.Spec.Key = "another-key"
+	errs := ValidateChallengeUpdate(old, specUpdate)
+	expErrs := field.ErrorList{field.Forbidden(field.NewPath("spec"), "field is immutable")}
+	if !reflect.DeepEqual(errs, expErrs) {
+		t.Errorf("Expected %v but got %v", expErrs, errs)
+	}
+}
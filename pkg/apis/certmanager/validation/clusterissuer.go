@@ -26,5 +26,6 @@ import (
 
 func ValidateClusterIssuer(iss *v1alpha1.ClusterIssuer) field.ErrorList {
 	allErrs := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, ValidateIssuerStatus(&iss.Status, field.NewPath("status"))...)
 	return allErrs
 }
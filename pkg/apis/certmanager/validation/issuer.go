@@ -19,6 +19,8 @@ package validation
 import (
 	"crypto/x509"
 	"fmt"
+	"math/big"
+	"net/url"
 	"strings"
 
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/rfc2136"
@@ -33,6 +35,7 @@ import (
 
 func ValidateIssuer(iss *v1alpha1.Issuer) field.ErrorList {
 	allErrs := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, ValidateIssuerStatus(&iss.Status, field.NewPath("status"))...)
 	return allErrs
 }
 
@@ -114,6 +117,67 @@ func ValidateCAIssuerConfig(iss *v1alpha1.CAIssuer, fldPath *field.Path) field.E
 	if len(iss.SecretName) == 0 {
 		el = append(el, field.Required(fldPath.Child("secretName"), ""))
 	}
+	el = append(el, validateURLs(iss.CRLDistributionPoints, fldPath.Child("crlDistributionPoints"))...)
+	el = append(el, validateURLs(iss.OCSPServers, fldPath.Child("ocspServers"))...)
+	el = append(el, validateURLs(iss.IssuingCertificateURLs, fldPath.Child("issuingCertificateURLs"))...)
+	if iss.CRL != nil {
+		el = append(el, validateCACRLConfig(iss.CRL, iss.SecretName, fldPath.Child("crl"))...)
+	}
+	if iss.OCSP != nil {
+		el = append(el, validateCAOCSPConfig(iss.OCSP, iss.SecretName, fldPath.Child("ocsp"))...)
+	}
+	return el
+}
+
+func validateCACRLConfig(crl *v1alpha1.CACRLConfig, caSecretName string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(crl.SecretName) == 0 {
+		el = append(el, field.Required(fldPath.Child("secretName"), ""))
+	} else if crl.SecretName == caSecretName {
+		el = append(el, field.Invalid(fldPath.Child("secretName"), crl.SecretName, "must not be the same as the Secret containing the CA key pair"))
+	}
+	if crl.UpdatePeriod != nil && crl.UpdatePeriod.Duration < v1alpha1.MinimumCRLUpdatePeriod {
+		el = append(el, field.Invalid(fldPath.Child("updatePeriod"), crl.UpdatePeriod.Duration, fmt.Sprintf("must be at least %s", v1alpha1.MinimumCRLUpdatePeriod)))
+	}
+	return el
+}
+
+func validateCAOCSPConfig(ocsp *v1alpha1.CAOCSPConfig, caSecretName string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(ocsp.ResponderSecretName) == 0 {
+		el = append(el, field.Required(fldPath.Child("responderSecretName"), ""))
+	} else if ocsp.ResponderSecretName == caSecretName {
+		el = append(el, field.Invalid(fldPath.Child("responderSecretName"), ocsp.ResponderSecretName, "must not be the same as the Secret containing the CA key pair"))
+	}
+	return el
+}
+
+// validateURLs checks that each of the given strings is an absolute URL
+func validateURLs(urls []string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	for i, u := range urls {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			el = append(el, field.Invalid(fldPath.Index(i), u, fmt.Sprintf("invalid URL: %v", err)))
+			continue
+		}
+		if !parsed.IsAbs() || parsed.Host == "" {
+			el = append(el, field.Invalid(fldPath.Index(i), u, "URL must be absolute"))
+		}
+	}
+	return el
+}
+
+func ValidateIssuerStatus(status *v1alpha1.IssuerStatus, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if status.CA == nil {
+		return el
+	}
+	for i, r := range status.CA.RevokedCertificates {
+		if _, ok := new(big.Int).SetString(r.SerialNumber, 16); !ok {
+			el = append(el, field.Invalid(fldPath.Child("ca", "revokedCertificates").Index(i).Child("serialNumber"), r.SerialNumber, "must be a hexadecimal serial number"))
+		}
+	}
 	return el
 }
 
@@ -17,10 +17,13 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
@@ -240,6 +243,115 @@ func TestValidateIssuerSpec(t *testing.T) {
 			},
 			errs: []*field.Error{field.Required(fldPath.Child("ca", "secretName"), "")},
 		},
+		"valid ca issuer with CRL and OCSP URLs": {
+			spec: &v1alpha1.IssuerSpec{
+				IssuerConfig: v1alpha1.IssuerConfig{
+					CA: &v1alpha1.CAIssuer{
+						SecretName:             "valid",
+						CRLDistributionPoints:  []string{"http://ca.example.com/crl"},
+						OCSPServers:            []string{"http://ocsp.example.com"},
+						IssuingCertificateURLs: []string{"http://ca.example.com/ca.crt"},
+					},
+				},
+			},
+		},
+		"ca issuer with invalid URLs": {
+			spec: &v1alpha1.IssuerSpec{
+				IssuerConfig: v1alpha1.IssuerConfig{
+					CA: &v1alpha1.CAIssuer{
+						SecretName:             "valid",
+						CRLDistributionPoints:  []string{"/crl"},
+						OCSPServers:            []string{"ocsp.example.com"},
+						IssuingCertificateURLs: []string{"http://ca.example.com/ca.crt", "http://"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ca", "crlDistributionPoints").Index(0), "/crl", "URL must be absolute"),
+				field.Invalid(fldPath.Child("ca", "ocspServers").Index(0), "ocsp.example.com", "URL must be absolute"),
+				field.Invalid(fldPath.Child("ca", "issuingCertificateURLs").Index(1), "http://", "URL must be absolute"),
+			},
+		},
+		"valid ca issuer with a CRL": {
+			spec: &v1alpha1.IssuerSpec{
+				IssuerConfig: v1alpha1.IssuerConfig{
+					CA: &v1alpha1.CAIssuer{
+						SecretName: "valid",
+						CRL: &v1alpha1.CACRLConfig{
+							SecretName:     "valid-crl",
+							UpdatePeriod:   &metav1.Duration{Duration: time.Hour},
+							RevokeOnDelete: true,
+						},
+					},
+				},
+			},
+		},
+		"ca issuer with an invalid CRL": {
+			spec: &v1alpha1.IssuerSpec{
+				IssuerConfig: v1alpha1.IssuerConfig{
+					CA: &v1alpha1.CAIssuer{
+						SecretName: "valid",
+						CRL: &v1alpha1.CACRLConfig{
+							SecretName:   "valid",
+							UpdatePeriod: &metav1.Duration{Duration: time.Minute},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ca", "crl", "secretName"), "valid", "must not be the same as the Secret containing the CA key pair"),
+				field.Invalid(fldPath.Child("ca", "crl", "updatePeriod"), time.Minute, fmt.Sprintf("must be at least %s", v1alpha1.MinimumCRLUpdatePeriod)),
+			},
+		},
+		"ca issuer with a CRL without a secret name": {
+			spec: &v1alpha1.IssuerSpec{
+				IssuerConfig: v1alpha1.IssuerConfig{
+					CA: &v1alpha1.CAIssuer{
+						SecretName: "valid",
+						CRL:        &v1alpha1.CACRLConfig{},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("ca", "crl", "secretName"), ""),
+			},
+		},
+		"valid ca issuer with a delegated OCSP responder": {
+			spec: &v1alpha1.IssuerSpec{
+				IssuerConfig: v1alpha1.IssuerConfig{
+					CA: &v1alpha1.CAIssuer{
+						SecretName: "valid",
+						OCSP:       &v1alpha1.CAOCSPConfig{ResponderSecretName: "valid-ocsp"},
+					},
+				},
+			},
+		},
+		"ca issuer with a delegated OCSP responder using the CA secret": {
+			spec: &v1alpha1.IssuerSpec{
+				IssuerConfig: v1alpha1.IssuerConfig{
+					CA: &v1alpha1.CAIssuer{
+						SecretName: "valid",
+						OCSP:       &v1alpha1.CAOCSPConfig{ResponderSecretName: "valid"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ca", "ocsp", "responderSecretName"), "valid", "must not be the same as the Secret containing the CA key pair"),
+			},
+		},
+		"ca issuer with an OCSP config without a responder secret name": {
+			spec: &v1alpha1.IssuerSpec{
+				IssuerConfig: v1alpha1.IssuerConfig{
+					CA: &v1alpha1.CAIssuer{
+						SecretName: "valid",
+						OCSP:       &v1alpha1.CAOCSPConfig{},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("ca", "ocsp", "responderSecretName"), ""),
+			},
+		},
 		"valid self signed issuer": {
 			spec: &v1alpha1.IssuerSpec{
 				IssuerConfig: v1alpha1.IssuerConfig{
@@ -300,6 +412,58 @@ func TestValidateIssuerSpec(t *testing.T) {
 	}
 }
 
+func TestValidateIssuerStatus(t *testing.T) {
+	fldPath := field.NewPath("status")
+	scenarios := map[string]struct {
+		status *v1alpha1.IssuerStatus
+		errs   []*field.Error
+	}{
+		"empty status": {
+			status: &v1alpha1.IssuerStatus{},
+		},
+		"valid revoked certificates": {
+			status: &v1alpha1.IssuerStatus{
+				CA: &v1alpha1.CAIssuerStatus{
+					RevokedCertificates: []v1alpha1.RevokedCertificate{
+						{SerialNumber: "0A1B2C"},
+						{SerialNumber: "ff"},
+					},
+				},
+			},
+		},
+		"invalid revoked certificate serial numbers": {
+			status: &v1alpha1.IssuerStatus{
+				CA: &v1alpha1.CAIssuerStatus{
+					RevokedCertificates: []v1alpha1.RevokedCertificate{
+						{SerialNumber: "0A1B2C"},
+						{SerialNumber: "not-hex"},
+						{},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ca", "revokedCertificates").Index(1).Child("serialNumber"), "not-hex", "must be a hexadecimal serial number"),
+				field.Invalid(fldPath.Child("ca", "revokedCertificates").Index(2).Child("serialNumber"), "", "must be a hexadecimal serial number"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateIssuerStatus(s.status, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
 func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 	fldPath := field.NewPath("")
 	providersPath := fldPath.Child("providers")
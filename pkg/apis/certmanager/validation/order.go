@@ -0,0 +1,73 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/x509"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+// Validation functions for cert-manager v1alpha1 Order types
+
+func ValidateOrder(o *v1alpha1.Order) field.ErrorList {
+	allErrs := ValidateOrderSpec(&o.Spec, field.NewPath("spec"))
+	return allErrs
+}
+
+// ValidateOrderUpdate validates an updated Order. The spec of an Order cannot
+// be changed once it has been created.
+func ValidateOrderUpdate(oldOrder, newOrder *v1alpha1.Order) field.ErrorList {
+	allErrs := ValidateOrder(newOrder)
+	if !apiequality.Semantic.DeepEqual(oldOrder.Spec, newOrder.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "field is immutable"))
+	}
+	return allErrs
+}
+
+func ValidateOrderSpec(spec *v1alpha1.OrderSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(spec.CSR) == 0 {
+		el = append(el, field.Required(fldPath.Child("csr"), "must be specified"))
+	} else if _, err := x509.ParseCertificateRequest(spec.CSR); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("csr"), "", err.Error()))
+	}
+	el = append(el, validateIssuerRef(&spec.IssuerRef, fldPath.Child("issuerRef"))...)
+	if spec.CommonName == "" && len(spec.DNSNames) == 0 {
+		el = append(el, field.Required(fldPath.Child("dnsNames"), "at least one dnsName is required if commonName is not set"))
+	}
+	return el
+}
+
+// validateIssuerRef validates the issuerRef field of resources created by
+// cert-manager for a Certificate, which must always refer to an Issuer or
+// ClusterIssuer.
+func validateIssuerRef(ref *v1alpha1.ObjectReference, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if ref.Name == "" {
+		el = append(el, field.Required(fldPath.Child("name"), "must be specified"))
+	}
+	switch ref.Kind {
+	case "", v1alpha1.IssuerKind, v1alpha1.ClusterIssuerKind:
+	default:
+		el = append(el, field.Invalid(fldPath.Child("kind"), ref.Kind, "must be one of Issuer or ClusterIssuer"))
+	}
+	return el
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+func TestValidateOrder(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	block, _ := pem.Decode(generateCSR(t, "testcn"))
+	csr := block.Bytes
+	badCSR := []byte("not a csr")
+	_, badCSRErr := x509.ParseCertificateRequest(badCSR)
+
+	scenarios := map[string]struct {
+		order *v1alpha1.Order
+		errs  []*field.Error
+	}{
+		"valid order": {
+			order: &v1alpha1.Order{
+				Spec: v1alpha1.OrderSpec{
+					CSR:       csr,
+					IssuerRef: validIssuerRef,
+					DNSNames:  []string{"example.com"},
+				},
+			},
+		},
+		"order with no csr": {
+			order: &v1alpha1.Order{
+				Spec: v1alpha1.OrderSpec{
+					IssuerRef:  validIssuerRef,
+					CommonName: "example.com",
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("csr"), "must be specified"),
+			},
+		},
+		"order with invalid csr": {
+			order: &v1alpha1.Order{
+				Spec: v1alpha1.OrderSpec{
+					CSR:        badCSR,
+					IssuerRef:  validIssuerRef,
+					CommonName: "example.com",
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("csr"), "", badCSRErr.Error()),
+			},
+		},
+		"order with external issuer kind": {
+			order: &v1alpha1.Order{
+				Spec: v1alpha1.OrderSpec{
+					CSR:        csr,
+					IssuerRef:  v1alpha1.ObjectReference{Name: "valid", Kind: "ExternalIssuer"},
+					CommonName: "example.com",
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("issuerRef", "kind"), "ExternalIssuer", "must be one of Issuer or ClusterIssuer"),
+			},
+		},
+		"order with no names": {
+			order: &v1alpha1.Order{
+				Spec: v1alpha1.OrderSpec{
+					CSR:       csr,
+					IssuerRef: validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("dnsNames"), "at least one dnsName is required if commonName is not set"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateOrder(s.order)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateOrderUpdate(t *testing.T) {
+	block, _ := pem.Decode(generateCSR(t, "testcn"))
+	old := &v1alpha1.Order{
+		Spec: v1alpha1.OrderSpec{
+			CSR:       block.Bytes,
+			IssuerRef: validIssuerRef,
+			DNSNames:  []string{"example.com"},
+		},
+	}
+
+	statusUpdate := old.DeepCopy()
+	statusUpdate.Status.State = v1alpha1.Pending
+	statusUpdate.Finalizers = []string{v1alpha1.ACMEFinalizer}
+	if errs := ValidateOrderUpdate(old, statusUpdate); len(errs) != 0 {
+		t.Errorf("Expected no errors updating order status but got %v", errs)
+	}
+
+	specUpdate := old.DeepCopy()
+	specUpdate.Spec.DNSNames = []string{"example.org"}
+	errs := ValidateOrderUpdate(old, specUpdate)
+	expErrs := field.ErrorList{field.Forbidden(field.NewPath("spec"), "field is immutable")}
+	if !reflect.DeepEqual(errs, expErrs) {
+		t.Errorf("Expected %v but got %v", expErrs, errs)
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/install"
+)
+
+var (
+	scheme       = runtime.NewScheme()
+	deserializer runtime.Decoder
+)
+
+func init() {
+	install.Install(scheme)
+	deserializer = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+}
+
+// decodeObject decodes the given JSON encoded object using the API version set
+// on it, and converts it into out via the internal version of the API group.
+// This allows resources submitted using any served version to be validated
+// using the v1alpha1 validation functions.
+func decodeObject(data []byte, out runtime.Object) error {
+	obj, gvk, err := deserializer.Decode(data, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to decode object: %v", err)
+	}
+	if gvk.Group != certmanager.GroupName {
+		return fmt.Errorf("unsupported API group %q", gvk.Group)
+	}
+
+	internal, err := scheme.ConvertToVersion(obj, certmanager.SchemeGroupVersion)
+	if err != nil {
+		return fmt.Errorf("failed to convert %s to internal version: %v", gvk.Kind, err)
+	}
+
+	return scheme.Convert(internal, out, nil)
+}
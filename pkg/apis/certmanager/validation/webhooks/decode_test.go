@@ -0,0 +1,90 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+func TestDecodeObject(t *testing.T) {
+	tests := map[string]struct {
+		data   string
+		exp    *v1alpha1.Issuer
+		expErr bool
+	}{
+		"decodes v1alpha1 issuer": {
+			data: `{"apiVersion":"cert-manager.io/v1alpha1","kind":"Issuer","metadata":{"name":"test"},
+				"spec":{"vault":{"server":"https://vault","path":"pki","auth":{"tokenSecretRef":{"name":"token","key":"key"}}}}}`,
+			exp: vaultIssuer(v1alpha1.VaultAuth{
+				TokenSecretRef: v1alpha1.SecretKeySelector{
+					LocalObjectReference: v1alpha1.LocalObjectReference{Name: "token"},
+					Key:                  "key",
+				},
+			}),
+		},
+		"converts v1alpha2 issuer": {
+			data: `{"apiVersion":"cert-manager.io/v1alpha2","kind":"Issuer","metadata":{"name":"test"},
+				"spec":{"vault":{"server":"https://vault","path":"pki","auth":{"appRole":{"path":"approle","roleId":"role","secretRef":{"name":"secret"}}}}}}`,
+			exp: vaultIssuer(v1alpha1.VaultAuth{
+				AppRole: v1alpha1.VaultAppRole{
+					Path:   "approle",
+					RoleId: "role",
+					SecretRef: v1alpha1.SecretKeySelector{
+						LocalObjectReference: v1alpha1.LocalObjectReference{Name: "secret"},
+					},
+				},
+			}),
+		},
+		"fails for objects from another group": {
+			data:   `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"test"}}`,
+			expErr: true,
+		},
+		"fails for objects without a kind": {
+			data:   `{"metadata":{"name":"test"}}`,
+			expErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			out := &v1alpha1.Issuer{}
+			err := decodeObject([]byte(test.data), out)
+			if err != nil != test.expErr {
+				t.Fatalf("expected error=%t but got: %v", test.expErr, err)
+			}
+			if test.expErr {
+				return
+			}
+			if !reflect.DeepEqual(out, test.exp) {
+				t.Errorf("unexpected decoded object.\nexp=%+v\ngot=%+v", test.exp, out)
+			}
+		})
+	}
+}
+
+func vaultIssuer(auth v1alpha1.VaultAuth) *v1alpha1.Issuer {
+	iss := &v1alpha1.Issuer{}
+	iss.Name = "test"
+	iss.Spec.Vault = &v1alpha1.VaultIssuer{
+		Server: "https://vault",
+		Path:   "pki",
+		Auth:   auth,
+	}
+	return iss
+}
@@ -17,7 +17,6 @@ limitations under the License.
 package webhooks
 
 import (
-	"encoding/json"
 	"net/http"
 
 	admissionv1beta1 "k8s.io/api/admission/v1beta1"
@@ -48,7 +47,7 @@ func (c *IssuerAdmissionHook) Validate(admissionSpec *admissionv1beta1.Admission
 	status := &admissionv1beta1.AdmissionResponse{}
 
 	obj := &v1alpha1.Issuer{}
-	err := json.Unmarshal(admissionSpec.Object.Raw, obj)
+	err := decodeObject(admissionSpec.Object.Raw, obj)
 	if err != nil {
 		status.Allowed = false
 		status.Result = &metav1.Status{
@@ -28,13 +28,14 @@ import (
 type CertmanagerV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	CertificatesGetter
+	CertificateRequestsGetter
 	ChallengesGetter
 	ClusterIssuersGetter
 	IssuersGetter
 	OrdersGetter
 }
 
-// CertmanagerV1alpha1Client is used to interact with features provided by the certmanager.k8s.io group.
+// CertmanagerV1alpha1Client is used to interact with features provided by the cert-manager.io group.
 type CertmanagerV1alpha1Client struct {
 	restClient rest.Interface
 }
@@ -43,6 +44,10 @@ func (c *CertmanagerV1alpha1Client) Certificates(namespace string) CertificateIn
 	return newCertificates(c, namespace)
 }
 
+func (c *CertmanagerV1alpha1Client) CertificateRequests(namespace string) CertificateRequestInterface {
+	return newCertificateRequests(c, namespace)
+}
+
 func (c *CertmanagerV1alpha1Client) Challenges(namespace string) ChallengeInterface {
 	return newChallenges(c, namespace)
 }
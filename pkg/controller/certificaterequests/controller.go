@@ -0,0 +1,223 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificaterequests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1alpha1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+// Controller signs CertificateRequest resources that reference an Issuer or
+// ClusterIssuer of a single issuer type. One Controller is run for each of
+// the issuer types supported by cert-manager.
+type Controller struct {
+	// the controllers root context, containing a controller scoped logger
+	ctx context.Context
+
+	*controllerpkg.Context
+
+	// issuerType is the name of the issuer implementation (e.g. 'ca') that
+	// this controller will sign CertificateRequests for.
+	issuerType string
+
+	helper        issuer.Helper
+	issuerFactory issuer.IssuerFactory
+
+	// To allow injection for testing.
+	syncHandler func(ctx context.Context, key string) error
+
+	issuerLister             cmlisters.IssuerLister
+	clusterIssuerLister      cmlisters.ClusterIssuerLister
+	certificateRequestLister cmlisters.CertificateRequestLister
+
+	queue       workqueue.RateLimitingInterface
+	workerWg    sync.WaitGroup
+	syncedFuncs []cache.InformerSynced
+
+	// used for testing
+	clock clock.Clock
+}
+
+// New returns a new CertificateRequests controller for the given issuer type.
+// It sets up the informer handler functions for all the types it watches.
+func New(issuerType string, ctx *controllerpkg.Context) *Controller {
+	ctrl := &Controller{Context: ctx, issuerType: issuerType}
+	ctrl.syncHandler = ctrl.processNextWorkItem
+	ctrl.queue = workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName(issuerType))
+
+	certificateRequestInformer := ctrl.SharedInformerFactory.Certmanager().V1alpha1().CertificateRequests()
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: ctrl.queue})
+	ctrl.certificateRequestLister = certificateRequestInformer.Lister()
+	ctrl.syncedFuncs = append(ctrl.syncedFuncs, certificateRequestInformer.Informer().HasSynced)
+
+	issuerInformer := ctrl.SharedInformerFactory.Certmanager().V1alpha1().Issuers()
+	issuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: ctrl.handleGenericIssuer})
+	ctrl.issuerLister = issuerInformer.Lister()
+	ctrl.syncedFuncs = append(ctrl.syncedFuncs, issuerInformer.Informer().HasSynced)
+
+	// if scoped to a single namespace
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctrl.SharedInformerFactory.Certmanager().V1alpha1().ClusterIssuers()
+		clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: ctrl.handleGenericIssuer})
+		ctrl.clusterIssuerLister = clusterIssuerInformer.Lister()
+		ctrl.syncedFuncs = append(ctrl.syncedFuncs, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	// the ACME issuer creates Order resources owned by the CertificateRequest,
+	// so we must resync the CertificateRequest when these change.
+	if issuerType == apiutil.IssuerACME {
+		ordersInformer := ctrl.SharedInformerFactory.Certmanager().V1alpha1().Orders()
+		ordersInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: ctrl.handleOwnedResource})
+		ctrl.syncedFuncs = append(ctrl.syncedFuncs, ordersInformer.Informer().HasSynced)
+	}
+
+	ctrl.helper = issuer.NewHelper(ctrl.issuerLister, ctrl.clusterIssuerLister)
+	ctrl.issuerFactory = issuer.NewIssuerFactory(ctx)
+	ctrl.clock = clock.RealClock{}
+	ctrl.ctx = logf.NewContext(ctx.RootContext, nil, ControllerName(issuerType))
+
+	return ctrl
+}
+
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+	log := logf.FromContext(ctx)
+
+	log.Info("starting control loop")
+	// wait for all the informer caches we depend to sync
+	if !cache.WaitForCacheSync(stopCh, c.syncedFuncs...) {
+		return fmt.Errorf("error waiting for informer caches to sync")
+	}
+
+	log.Info("synced all caches for control loop")
+
+	for i := 0; i < workers; i++ {
+		c.workerWg.Add(1)
+		go wait.Until(func() { c.worker(ctx) }, time.Second, stopCh)
+	}
+	<-stopCh
+	log.V(logf.DebugLevel).Info("shutting down queue as workqueue signaled shutdown")
+	c.queue.ShutDown()
+	log.V(logf.DebugLevel).Info("waiting for workers to exit...")
+	c.workerWg.Wait()
+	log.V(logf.DebugLevel).Info("workers exited")
+	return nil
+}
+
+func (c *Controller) worker(ctx context.Context) {
+	log := logf.FromContext(ctx)
+	defer c.workerWg.Done()
+	log.V(logf.DebugLevel).Info("starting worker")
+	for {
+		obj, shutdown := c.queue.Get()
+		if shutdown {
+			break
+		}
+
+		var key string
+		// use an inlined function so we can use defer
+		func() {
+			defer c.queue.Done(obj)
+			var ok bool
+			if key, ok = obj.(string); !ok {
+				return
+			}
+			log := log.WithValues("key", key)
+			log.Info("syncing resource")
+			if err := c.syncHandler(ctx, key); err != nil {
+				log.Error(err, "re-queuing item  due to error processing")
+				c.queue.AddRateLimited(obj)
+				return
+			}
+			log.Info("finished processing work item")
+			c.queue.Forget(obj)
+		}()
+	}
+	log.V(logf.DebugLevel).Info("exiting worker loop")
+}
+
+func (c *Controller) processNextWorkItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key")
+		return nil
+	}
+
+	cr, err := c.certificateRequestLister.CertificateRequests(namespace).Get(name)
+	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			log.Error(err, "certificate request in work queue no longer exists")
+			return nil
+		}
+
+		return err
+	}
+
+	ctx = logf.NewContext(ctx, logf.WithResource(log, cr))
+	return c.Sync(ctx, cr)
+}
+
+var keyFunc = controllerpkg.KeyFunc
+
+const (
+	// ControllerNamePrefix is the prefix of the names of all the
+	// CertificateRequest controllers. The full name of each controller is
+	// given by ControllerName.
+	ControllerNamePrefix = "certificaterequests-issuer-"
+)
+
+// ControllerName returns the name of the CertificateRequest controller that
+// signs requests for the given issuer type.
+func ControllerName(issuerType string) string {
+	return ControllerNamePrefix + issuerType
+}
+
+// IssuerTypes is the list of issuer types that CertificateRequest controllers
+// are registered for.
+var IssuerTypes = []string{
+	apiutil.IssuerACME,
+	apiutil.IssuerCA,
+	apiutil.IssuerSelfSigned,
+	apiutil.IssuerVault,
+	apiutil.IssuerVenafi,
+}
+
+func init() {
+	for _, issuerType := range IssuerTypes {
+		// capture the loop variable for use in the constructor closure
+		issuerType := issuerType
+		controllerpkg.Register(ControllerName(issuerType), func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+			return New(issuerType, ctx).Run, nil
+		})
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificaterequests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/kr/pretty"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/validation"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	errorIssuerNotReady = "IssuerNotReady"
+	errorIssuerInit     = "IssuerInitError"
+	errorBadConfig      = "BadConfig"
+
+	successCertificateIssued = "CertificateIssued"
+)
+
+// Sync will attempt to sign the CertificateRequest using the issuer it
+// references, if that issuer is of the type handled by this controller.
+// CertificateRequests that reference kinds other than Issuer and
+// ClusterIssuer are ignored, so that they can be handled by external signers.
+func (c *Controller) Sync(ctx context.Context, cr *v1alpha1.CertificateRequest) (err error) {
+	log := logf.FromContext(ctx)
+	dbg := log.V(logf.DebugLevel)
+
+	switch cr.Spec.IssuerRef.Kind {
+	case "", v1alpha1.IssuerKind, v1alpha1.ClusterIssuerKind:
+	default:
+		dbg.Info("certificate request references an external issuer kind, ignoring", "kind", cr.Spec.IssuerRef.Kind)
+		return nil
+	}
+
+	// a CertificateRequest that has been issued or has failed will never be
+	// processed again
+	switch apiutil.CertificateRequestReadyReason(cr) {
+	case v1alpha1.CertificateRequestReasonIssued, v1alpha1.CertificateRequestReasonFailed:
+		dbg.Info("certificate request is in a final state, ignoring")
+		return nil
+	}
+
+	issuerObj, err := c.helper.GetGenericIssuer(cr.Spec.IssuerRef, cr.Namespace)
+	if k8sErrors.IsNotFound(err) {
+		// the request will be re-synced once the issuer has been created
+		dbg.Info("issuer referenced by certificate request does not exist", "issuer_name", cr.Spec.IssuerRef.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	issuerType, err := apiutil.NameForIssuer(issuerObj)
+	if err != nil {
+		dbg.Info("could not determine issuer type for issuer", "error", err.Error())
+		return nil
+	}
+	// the request is handled by a different CertificateRequest controller
+	if issuerType != c.issuerType {
+		return nil
+	}
+
+	crCopy := cr.DeepCopy()
+	defer func() {
+		if _, saveErr := c.updateCertificateRequestStatus(ctx, cr, crCopy); saveErr != nil {
+			err = utilerrors.NewAggregate([]error{saveErr, err})
+		}
+	}()
+
+	el := validation.ValidateCertificateRequest(crCopy)
+	if len(el) > 0 {
+		msg := fmt.Sprintf("Resource validation failed: %v", el.ToAggregate())
+		c.Recorder.Event(crCopy, corev1.EventTypeWarning, errorBadConfig, msg)
+		c.setFailed(crCopy, msg)
+		return nil
+	}
+
+	issuerReady := apiutil.IssuerHasCondition(issuerObj, v1alpha1.IssuerCondition{
+		Type:   v1alpha1.IssuerConditionReady,
+		Status: v1alpha1.ConditionTrue,
+	})
+	if !issuerReady {
+		msg := fmt.Sprintf("Issuer %s not ready", issuerObj.GetObjectMeta().Name)
+		c.Recorder.Event(crCopy, corev1.EventTypeWarning, errorIssuerNotReady, msg)
+		apiutil.SetCertificateRequestCondition(crCopy, v1alpha1.CertificateRequestConditionReady, v1alpha1.ConditionFalse, v1alpha1.CertificateRequestReasonPending, msg)
+		return nil
+	}
+
+	i, err := c.issuerFactory.IssuerFor(issuerObj)
+	if err != nil {
+		c.Recorder.Eventf(crCopy, corev1.EventTypeWarning, errorIssuerInit, "Internal error initialising issuer: %v", err)
+		return nil
+	}
+
+	resp, err := i.Sign(ctx, crCopy)
+	if err != nil {
+		log.Error(err, "error signing certificate request")
+		return err
+	}
+
+	// if the issuer has not returned any data, it may have marked the
+	// request as failed
+	if resp == nil {
+		if apiutil.CertificateRequestReadyReason(crCopy) == v1alpha1.CertificateRequestReasonFailed && crCopy.Status.FailureTime == nil {
+			nowTime := metav1.NewTime(c.clock.Now())
+			crCopy.Status.FailureTime = &nowTime
+		}
+		return nil
+	}
+
+	crCopy.Status.Certificate = resp.Certificate
+	crCopy.Status.CA = resp.CA
+	apiutil.SetCertificateRequestCondition(crCopy, v1alpha1.CertificateRequestConditionReady, v1alpha1.ConditionTrue, v1alpha1.CertificateRequestReasonIssued, "Certificate fetched from issuer successfully")
+	c.Recorder.Event(crCopy, corev1.EventTypeNormal, successCertificateIssued, "Certificate fetched from issuer successfully")
+
+	return nil
+}
+
+// setFailed marks the given CertificateRequest as failed, recording the time
+// of the failure. It will not actually submit the resource to the apiserver.
+func (c *Controller) setFailed(cr *v1alpha1.CertificateRequest, message string) {
+	apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady, v1alpha1.ConditionFalse, v1alpha1.CertificateRequestReasonFailed, message)
+	nowTime := metav1.NewTime(c.clock.Now())
+	cr.Status.FailureTime = &nowTime
+}
+
+func (c *Controller) updateCertificateRequestStatus(ctx context.Context, old, new *v1alpha1.CertificateRequest) (*v1alpha1.CertificateRequest, error) {
+	log := logf.FromContext(ctx, "updateStatus")
+	oldBytes, _ := json.Marshal(old.Status)
+	newBytes, _ := json.Marshal(new.Status)
+	if reflect.DeepEqual(oldBytes, newBytes) {
+		return nil, nil
+	}
+	log.V(logf.DebugLevel).Info("updating resource due to change in status", "diff", pretty.Diff(string(oldBytes), string(newBytes)))
+	// TODO: replace Update call with UpdateStatus. This requires a custom API
+	// server with the /status subresource enabled and/or subresource support
+	// for CRDs (https://github.com/kubernetes/kubernetes/issues/38113)
+	return c.CMClient.CertmanagerV1alpha1().CertificateRequests(new.Namespace).Update(new)
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificaterequests
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	clock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	"github.com/jetstack/cert-manager/pkg/issuer/fake"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func generateCSR(t *testing.T, commonName string) []byte {
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Errorf("failed to generate private key: %v", err)
+		t.FailNow()
+	}
+
+	csr, err := pki.EncodeCSR(&x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: commonName},
+	}, pk)
+	if err != nil {
+		t.Errorf("failed to encode csr: %v", err)
+		t.FailNow()
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})
+}
+
+func TestSync(t *testing.T) {
+	nowTime := time.Now()
+	nowMetaTime := metav1.NewTime(nowTime)
+	fixedClock := clock.NewFakeClock(nowTime)
+
+	csrPEM := generateCSR(t, "example.com")
+	certPEM := []byte("signed certificate")
+	caPEM := []byte("ca certificate")
+
+	caIssuer := gen.Issuer("test",
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmapi.ConditionTrue,
+		}),
+		gen.SetIssuerCA(cmapi.CAIssuer{}),
+	)
+	caIssuerNotReady := gen.Issuer("test",
+		gen.SetIssuerCA(cmapi.CAIssuer{}),
+	)
+
+	exampleCR := gen.CertificateRequest("test",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestIssuer(cmapi.ObjectReference{Name: "test"}),
+	)
+	exampleCRIssued := gen.CertificateRequestFrom(exampleCR,
+		gen.SetCertificateRequestCertificate(certPEM),
+		gen.SetCertificateRequestCA(caPEM),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionReady,
+			Status:             cmapi.ConditionTrue,
+			Reason:             cmapi.CertificateRequestReasonIssued,
+			Message:            "Certificate fetched from issuer successfully",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+	exampleCRFailed := gen.CertificateRequestFrom(exampleCR,
+		gen.SetCertificateRequestFailureTime(nowMetaTime),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionReady,
+			Status:             cmapi.ConditionFalse,
+			Reason:             cmapi.CertificateRequestReasonFailed,
+			Message:            "signing failed",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+	exampleCRBadCSR := gen.CertificateRequestFrom(exampleCR,
+		gen.SetCertificateRequestCSR([]byte("bad csr")),
+	)
+	exampleCRBadCSRFailed := gen.CertificateRequestFrom(exampleCRBadCSR,
+		gen.SetCertificateRequestFailureTime(nowMetaTime),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionReady,
+			Status:             cmapi.ConditionFalse,
+			Reason:             cmapi.CertificateRequestReasonFailed,
+			Message:            "Resource validation failed: spec.csr: Invalid value: []byte{0x62, 0x61, 0x64, 0x20, 0x63, 0x73, 0x72}: error decoding certificate request PEM block",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+	exampleCRIssuerNotReady := gen.CertificateRequestFrom(exampleCR,
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionReady,
+			Status:             cmapi.ConditionFalse,
+			Reason:             cmapi.CertificateRequestReasonPending,
+			Message:            "Issuer test not ready",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+	exampleCRExternalIssuer := gen.CertificateRequestFrom(exampleCR,
+		gen.SetCertificateRequestIssuer(cmapi.ObjectReference{Name: "test", Kind: "ExternalIssuer"}),
+	)
+
+	signIssued := &fake.Issuer{
+		FakeSign: func(context.Context, *cmapi.CertificateRequest) (*issuer.IssueResponse, error) {
+			return &issuer.IssueResponse{
+				Certificate: certPEM,
+				CA:          caPEM,
+			}, nil
+		},
+	}
+	signNotCalled := &fake.Issuer{
+		FakeSign: func(context.Context, *cmapi.CertificateRequest) (*issuer.IssueResponse, error) {
+			return nil, errors.New("unexpected call to Sign")
+		},
+	}
+
+	signError := &fake.Issuer{
+		FakeSign: func(context.Context, *cmapi.CertificateRequest) (*issuer.IssueResponse, error) {
+			return nil, errors.New("failed to sign")
+		},
+	}
+
+	tests := map[string]controllerFixture{
+		"should set the signed certificate and CA on the certificate request": {
+			IssuerType:         apiutil.IssuerCA,
+			Issuer:             caIssuer,
+			CertificateRequest: exampleCR,
+			IssuerImpl:         signIssued,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{exampleCR},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						exampleCRIssued,
+					)),
+				},
+			},
+		},
+		"should do nothing if the issuer is of a different type": {
+			IssuerType:         apiutil.IssuerVault,
+			Issuer:             caIssuer,
+			CertificateRequest: exampleCR,
+			IssuerImpl:         signNotCalled,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{exampleCR},
+			},
+		},
+		"should do nothing if the certificate request references an external issuer kind": {
+			IssuerType:         apiutil.IssuerCA,
+			Issuer:             caIssuer,
+			CertificateRequest: exampleCRExternalIssuer,
+			IssuerImpl:         signNotCalled,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{exampleCRExternalIssuer},
+			},
+		},
+		"should do nothing if the certificate request has already been issued": {
+			IssuerType:         apiutil.IssuerCA,
+			Issuer:             caIssuer,
+			CertificateRequest: exampleCRIssued,
+			IssuerImpl:         signNotCalled,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{exampleCRIssued},
+			},
+		},
+		"should do nothing if the certificate request has failed": {
+			IssuerType:         apiutil.IssuerCA,
+			Issuer:             caIssuer,
+			CertificateRequest: exampleCRFailed,
+			IssuerImpl:         signNotCalled,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{exampleCRFailed},
+			},
+		},
+		"should mark the certificate request as failed if the csr is invalid": {
+			IssuerType:         apiutil.IssuerCA,
+			Issuer:             caIssuer,
+			CertificateRequest: exampleCRBadCSR,
+			IssuerImpl:         signNotCalled,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{exampleCRBadCSR},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						exampleCRBadCSRFailed,
+					)),
+				},
+			},
+		},
+		"should mark the certificate request as pending if the issuer is not ready": {
+			IssuerType:         apiutil.IssuerCA,
+			Issuer:             caIssuerNotReady,
+			CertificateRequest: exampleCR,
+			IssuerImpl:         signNotCalled,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{exampleCR},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						exampleCRIssuerNotReady,
+					)),
+				},
+			},
+		},
+		"should set the failure time if the issuer marks the certificate request as failed": {
+			IssuerType:         apiutil.IssuerCA,
+			Issuer:             caIssuer,
+			CertificateRequest: exampleCR,
+			IssuerImpl: &fake.Issuer{
+				FakeSign: func(_ context.Context, cr *cmapi.CertificateRequest) (*issuer.IssueResponse, error) {
+					apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady, cmapi.ConditionFalse, cmapi.CertificateRequestReasonFailed, "signing failed")
+					return nil, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{exampleCR},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						exampleCRFailed,
+					)),
+				},
+			},
+		},
+		"should return an error and not update the certificate request if signing errors": {
+			IssuerType:         apiutil.IssuerCA,
+			Issuer:             caIssuer,
+			CertificateRequest: exampleCR,
+			IssuerImpl:         signError,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{exampleCR},
+			},
+			Err: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.Builder == nil {
+				test.Builder = &testpkg.Builder{}
+			}
+			if test.Clock == nil {
+				test.Clock = fixedClock
+			}
+			test.Setup(t)
+			crCopy := test.CertificateRequest.DeepCopy()
+			err := test.Controller.Sync(test.Ctx, crCopy)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, crCopy, err)
+		})
+	}
+}
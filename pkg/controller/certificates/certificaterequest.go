@@ -0,0 +1,406 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/kube"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	reasonRequestCreated = "Requested"
+	reasonRequestFailed  = "RequestFailed"
+)
+
+// requestCertificate will obtain a signed certificate for the given
+// Certificate by creating a CertificateRequest resource containing a CSR for
+// the private key stored in the Certificate's secret.
+// Once the CertificateRequest has been signed by its issuer, the certificate
+// and CA will be stored in the target secret alongside the private key.
+// - If the secret does not contain a usable private key, a new one is
+//   generated and stored with a temporary certificate before any request is
+//   made.
+// - If a certificate has already been issued, and either the Certificate's
+//   private key rotation policy is Always or the existing private key no
+//   longer matches the Certificate's spec, the request is made for a new
+//   private key stored in a separate secret, which only replaces the existing
+//   private key once the new certificate has been issued.
+// - CertificateRequests owned by the Certificate that no longer match the
+//   desired request will be deleted.
+func (c *Controller) requestCertificate(ctx context.Context, issuerObj v1alpha1.GenericIssuer, crt *v1alpha1.Certificate) error {
+	log := logf.FromContext(ctx, "requestCertificate")
+
+	rotate, err := c.privateKeyRotationRequired(crt)
+	if err != nil {
+		return err
+	}
+
+	keySecretName := crt.Spec.SecretName
+	var keyPEM []byte
+	var key crypto.Signer
+	if !rotate {
+		keyPEM, key, err = c.existingPrivateKey(crt)
+		if err != nil {
+			return err
+		}
+		// if the existing private key cannot be used but a certificate has
+		// already been issued for it, the new private key must be staged
+		// until a certificate has been issued for it too
+		if key == nil {
+			rotate, err = c.issuedCertificateStored(crt)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if rotate {
+		var created bool
+		keyPEM, key, created, err = c.ensureNextPrivateKey(ctx, crt)
+		if err != nil || created {
+			return err
+		}
+		keySecretName = apiutil.NextPrivateKeySecretName(crt)
+	}
+	if key == nil {
+		log.Info("generating new private key for certificate")
+		key, err = pki.GeneratePrivateKeyForCertificate(crt)
+		if err != nil {
+			c.Recorder.Eventf(crt, corev1.EventTypeWarning, errorConfig, "Error generating private key: %v", err)
+			return nil
+		}
+		keyPEM, err = pki.EncodePrivateKey(key)
+		if err != nil {
+			return err
+		}
+		// the update to the Secret will trigger the Certificate to be
+		// re-synced, at which point a CertificateRequest will be created
+		if _, err := c.updateSecret(ctx, crt, crt.Namespace, nil, keyPEM, nil); err != nil {
+			log.Error(err, "error saving private key")
+			c.Recorder.Event(crt, corev1.EventTypeWarning, errorSavingCertificate, messageErrorSavingCertificate+err.Error())
+			return err
+		}
+		return nil
+	}
+
+	// the existing certificate may be missing or invalid, in which case it
+	// is simply not used when computing the request name
+	existingCert, _ := kube.SecretTLSCert(ctx, c.secretLister, crt.Namespace, crt.Spec.SecretName)
+	expectedName, err := certificateRequestName(crt, key, existingCert)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.cleanupCertificateRequests(ctx, crt, expectedName)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		return c.createCertificateRequest(ctx, issuerObj, crt, expectedName, keySecretName, key)
+	}
+	log = logf.WithRelatedResource(log, existing)
+
+	// if the private key has changed since the request was created, the
+	// signed certificate will not be usable with our private key
+	csr, err := pki.DecodeX509CertificateRequestBytes(existing.Spec.CSRPEM)
+	if err != nil {
+		log.Error(err, "deleting certificate request with invalid csr")
+		return c.deleteCertificateRequest(existing)
+	}
+	matches, err := pki.PublicKeyMatchesCSR(key.Public(), csr)
+	if err != nil || !matches {
+		log.Info("deleting certificate request with csr not matching private key")
+		return c.deleteCertificateRequest(existing)
+	}
+
+	switch apiutil.CertificateRequestReadyReason(existing) {
+	case v1alpha1.CertificateRequestReasonFailed:
+		return c.handleFailedCertificateRequest(ctx, crt, existing)
+	case v1alpha1.CertificateRequestReasonIssued:
+	default:
+		log.V(logf.DebugLevel).Info("waiting for certificate request to be signed")
+		return nil
+	}
+
+	if _, err := c.updateSecret(ctx, crt, crt.Namespace, existing.Status.Certificate, keyPEM, existing.Status.CA); err != nil {
+		log.Error(err, "error saving certificate")
+		c.Recorder.Event(crt, corev1.EventTypeWarning, errorSavingCertificate, messageErrorSavingCertificate+err.Error())
+		return err
+	}
+
+	c.Recorder.Event(crt, corev1.EventTypeNormal, successCertificateIssued, "Certificate issued successfully")
+	markIssued(crt)
+	// the new private key is now stored in the target secret
+	if err := c.cleanupNextPrivateKey(crt); err != nil {
+		return err
+	}
+	// as we have just written a certificate, we should schedule it for renewal
+	c.scheduleRenewal(ctx, crt)
+
+	return nil
+}
+
+// existingPrivateKey returns the PEM encoded and decoded private key stored in
+// the Certificate's secret. If the secret does not exist, does not contain a
+// valid private key, or the private key does not match the algorithm and size
+// on the Certificate spec, nil will be returned.
+func (c *Controller) existingPrivateKey(crt *v1alpha1.Certificate) ([]byte, crypto.Signer, error) {
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if k8sErrors.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyPEM := secret.Data[corev1.TLSPrivateKeyKey]
+	if len(keyPEM) == 0 {
+		return nil, nil, nil
+	}
+	key, err := pki.DecodePrivateKeyBytes(keyPEM)
+	if err != nil {
+		return nil, nil, nil
+	}
+	if !privateKeyMatchesSpec(key, crt) {
+		return nil, nil, nil
+	}
+
+	return keyPEM, key, nil
+}
+
+// privateKeyMatchesSpec returns true if the given private key has the key
+// algorithm and size requested on the Certificate.
+func privateKeyMatchesSpec(key crypto.Signer, crt *v1alpha1.Certificate) bool {
+	switch crt.Spec.KeyAlgorithm {
+	case v1alpha1.KeyAlgorithm(""), v1alpha1.RSAKeyAlgorithm:
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return false
+		}
+		keySize := pki.MinRSAKeySize
+		if crt.Spec.KeySize > 0 {
+			keySize = crt.Spec.KeySize
+		}
+		return rsaKey.N.BitLen() == keySize
+	case v1alpha1.ECDSAKeyAlgorithm:
+		ecKey, ok := key.(*ecdsa.PrivateKey)
+		if !ok {
+			return false
+		}
+		keySize := pki.ECCurve256
+		if crt.Spec.KeySize > 0 {
+			keySize = crt.Spec.KeySize
+		}
+		return ecKey.Curve.Params().BitSize == keySize
+	case v1alpha1.Ed25519KeyAlgorithm:
+		_, ok := key.(ed25519.PrivateKey)
+		return ok
+	default:
+		return false
+	}
+}
+
+// certificateRequestName computes the name of the CertificateRequest that
+// should exist for the given Certificate. The name is derived from the
+// Certificate's spec, the public key of its private key, and the serial
+// number of the certificate currently stored in its secret (if any). This
+// ensures a new CertificateRequest is created whenever the spec or the private
+// key change, or once the current certificate has been replaced and needs
+// renewing in future. Defaults are applied to the spec before it is hashed,
+// so that the name does not change when the webhook persists them.
+func certificateRequestName(crt *v1alpha1.Certificate, key crypto.Signer, existingCert *x509.Certificate) (string, error) {
+	spec := crt.Spec.DeepCopy()
+	v1alpha1.SetDefaults_CertificateSpec(spec)
+	specBytes, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	pubKeyBytes, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return "", err
+	}
+
+	hashF := fnv.New32()
+	hashF.Write(specBytes)
+	hashF.Write(pubKeyBytes)
+	if existingCert != nil {
+		hashF.Write(existingCert.SerialNumber.Bytes())
+	}
+
+	return fmt.Sprintf("%s-%d", crt.Name, hashF.Sum32()), nil
+}
+
+// cleanupCertificateRequests deletes all CertificateRequests owned by the
+// Certificate other than the one with the expected name, which is returned
+// if it exists.
+func (c *Controller) cleanupCertificateRequests(ctx context.Context, crt *v1alpha1.Certificate, expectedName string) (*v1alpha1.CertificateRequest, error) {
+	log := logf.FromContext(ctx)
+
+	crs, err := c.certificateRequestLister.CertificateRequests(crt.Namespace).List(labels.NewSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	var existing *v1alpha1.CertificateRequest
+	for _, cr := range crs {
+		if !metav1.IsControlledBy(cr, crt) {
+			continue
+		}
+		if cr.Name == expectedName {
+			existing = cr
+			continue
+		}
+
+		log := logf.WithRelatedResource(log, cr)
+		log.V(logf.DebugLevel).Info("deleting certificate request no longer required by certificate")
+		if err := c.deleteCertificateRequest(cr); err != nil {
+			return nil, err
+		}
+	}
+
+	return existing, nil
+}
+
+// handleFailedCertificateRequest will delete a failed CertificateRequest once
+// the Certificate's issuance back-off period has elapsed, so that a new
+// request is created. Until then, the Certificate is scheduled to be
+// re-synced. The back-off period grows exponentially with the number of
+// consecutive failed issuance attempts.
+func (c *Controller) handleFailedCertificateRequest(ctx context.Context, crt *v1alpha1.Certificate, cr *v1alpha1.CertificateRequest) error {
+	log := logf.FromContext(ctx)
+
+	// if the Certificate does not have a LastFailureTime, this is the first
+	// time this failure has been observed and so it counts as a new attempt
+	if crt.Status.LastFailureTime == nil {
+		failureTime := metav1.NewTime(c.clock.Now())
+		if cr.Status.FailureTime != nil {
+			failureTime = *cr.Status.FailureTime
+		}
+		crt.Status.LastFailureTime = &failureTime
+		crt.Status.FailedIssuanceAttempts++
+	}
+
+	retryAt := crt.Status.LastFailureTime.Add(apiutil.CertificateIssuanceBackoff(crt))
+	retryIn := retryAt.Sub(c.clock.Now())
+	if retryIn > 0 {
+		c.Recorder.Eventf(crt, corev1.EventTypeWarning, reasonRequestFailed, "CertificateRequest %q failed, retrying in %s at %s",
+			cr.Name, retryIn.Round(time.Second), retryAt.Format(time.RFC3339))
+
+		key, err := keyFunc(crt)
+		if err != nil {
+			log.Error(err, "error getting key for certificate resource")
+			return nil
+		}
+		c.scheduledWorkQueue.Add(key, retryIn)
+		return nil
+	}
+
+	log.Info("deleting failed certificate request to retry issuance")
+	if err := c.deleteCertificateRequest(cr); err != nil {
+		return err
+	}
+	crt.Status.LastFailureTime = nil
+	return nil
+}
+
+// createCertificateRequest creates a CertificateRequest with the given name
+// for the Certificate, signed by the given private key. keySecretName is the
+// name of the secret the private key is stored in.
+func (c *Controller) createCertificateRequest(ctx context.Context, issuerObj v1alpha1.GenericIssuer, crt *v1alpha1.Certificate, name, keySecretName string, key crypto.Signer) error {
+	log := logf.FromContext(ctx)
+
+	template, err := pki.GenerateCSR(issuerObj, crt)
+	if err != nil {
+		c.Recorder.Eventf(crt, corev1.EventTypeWarning, errorConfig, "Error generating certificate request: %v", err)
+		return nil
+	}
+	derBytes, err := pki.EncodeCSR(template, key)
+	if err != nil {
+		return err
+	}
+	csrPEM := bytes.NewBuffer([]byte{})
+	if err := pem.Encode(csrPEM, &pem.Block{Type: "CERTIFICATE REQUEST", Bytes: derBytes}); err != nil {
+		return err
+	}
+
+	// copy across labels from the Certificate resource onto the
+	// CertificateRequest, and always set the certificate name label.
+	lbls := make(map[string]string, len(crt.Labels)+1)
+	for k, v := range crt.Labels {
+		lbls[k] = v
+	}
+	lbls[v1alpha1.CertificateNameKey] = crt.Name
+
+	cr := &v1alpha1.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: crt.Namespace,
+			Labels:    lbls,
+			Annotations: map[string]string{
+				v1alpha1.CRPrivateKeyAnnotationKey: keySecretName,
+			},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(crt, certificateGvk)},
+		},
+		Spec: v1alpha1.CertificateRequestSpec{
+			Duration:        crt.Spec.Duration,
+			IssuerRef:       crt.Spec.IssuerRef,
+			CSRPEM:          csrPEM.Bytes(),
+			IsCA:            crt.Spec.IsCA,
+			MaxPathLen:      crt.Spec.MaxPathLen,
+			NameConstraints: crt.Spec.NameConstraints,
+			Usages:          crt.Spec.Usages,
+		},
+	}
+
+	cr, err = c.CMClient.CertmanagerV1alpha1().CertificateRequests(crt.Namespace).Create(cr)
+	if err != nil {
+		log.Error(err, "error creating certificate request")
+		return err
+	}
+
+	c.Recorder.Eventf(crt, corev1.EventTypeNormal, reasonRequestCreated, "Created new CertificateRequest resource %q", cr.Name)
+	return nil
+}
+
+func (c *Controller) deleteCertificateRequest(cr *v1alpha1.CertificateRequest) error {
+	err := c.CMClient.CertmanagerV1alpha1().CertificateRequests(cr.Namespace).Delete(cr.Name, nil)
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
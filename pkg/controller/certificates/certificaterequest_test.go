@@ -0,0 +1,521 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"crypto"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	coretesting "k8s.io/client-go/testing"
+	clock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/feature"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestSyncWithCertificateRequests(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%s=true", feature.CertificateRequestControllers)); err != nil {
+		t.Fatal(err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%s=false", feature.CertificateRequestControllers))
+
+	nowTime := time.Now()
+	nowMetaTime := metav1.NewTime(nowTime)
+	fixedClock := clock.NewFakeClock(nowTime)
+
+	exampleCert := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test"}),
+		gen.SetCertificateSecretName("output"),
+	)
+	exampleCertTemporaryCondition := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:               cmapi.CertificateConditionReady,
+			Status:             cmapi.ConditionFalse,
+			Reason:             "TemporaryCertificate",
+			Message:            "Certificate issuance in progress. Temporary certificate issued.",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+	exampleCertNotFoundCondition := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:               cmapi.CertificateConditionReady,
+			Status:             cmapi.ConditionFalse,
+			Reason:             "NotFound",
+			Message:            "Certificate does not exist",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+	exampleIssuer := gen.Issuer("test",
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmapi.ConditionTrue,
+		}),
+		gen.SetIssuerCA(cmapi.CAIssuer{}),
+	)
+
+	pk1 := generatePrivateKey(t)
+	pk1PEM := pki.EncodePKCS1PrivateKey(pk1)
+	cert1PEM := generateSelfSignedCert(t, exampleCert, nil, pk1, nowTime, nowTime.Add(time.Hour*12))
+	pk2 := generatePrivateKey(t)
+
+	localTempCert := generateSelfSignedCert(t, exampleCert, big.NewInt(staticTemporarySerialNumber), pk1, nowTime, nowTime)
+	localTempX509Cert, err := pki.DecodeX509CertificateBytes(localTempCert)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secretWithTempCert := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: gen.DefaultTestNamespace,
+			Name:      "output",
+			SelfLink:  "abc",
+			Annotations: map[string]string{
+				cmapi.IssuerNameAnnotationKey: "test",
+				cmapi.IssuerKindAnnotationKey: "Issuer",
+			},
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       localTempCert,
+			corev1.TLSPrivateKeyKey: pk1PEM,
+		},
+	}
+
+	expectedCRName, err := certificateRequestName(exampleCert, pk1, localTempX509Cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	staleCRName, err := certificateRequestName(exampleCert, pk2, localTempX509Cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exampleCR := gen.CertificateRequest(expectedCRName,
+		gen.SetCertificateRequestIssuer(cmapi.ObjectReference{Name: "test"}),
+		gen.AddCertificateRequestOwnerReferences(*metav1.NewControllerRef(exampleCert, certificateGvk)),
+	)
+	// the CSR is generated by the controller so must be set per test case
+	csr1 := generateCSRForCertificate(t, exampleCert, pk1)
+	exampleCRPending := gen.CertificateRequestFrom(exampleCR,
+		gen.SetCertificateRequestCSR(csr1),
+	)
+	exampleCRIssued := gen.CertificateRequestFrom(exampleCRPending,
+		gen.SetCertificateRequestCertificate(cert1PEM),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:   cmapi.CertificateRequestConditionReady,
+			Status: cmapi.ConditionTrue,
+			Reason: cmapi.CertificateRequestReasonIssued,
+		}),
+	)
+	exampleCRFailed := gen.CertificateRequestFrom(exampleCRPending,
+		gen.SetCertificateRequestFailureTime(nowMetaTime),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:   cmapi.CertificateRequestConditionReady,
+			Status: cmapi.ConditionFalse,
+			Reason: cmapi.CertificateRequestReasonFailed,
+		}),
+	)
+	exampleCRFailedExpired := gen.CertificateRequestFrom(exampleCRFailed,
+		gen.SetCertificateRequestFailureTime(metav1.NewTime(nowTime.Add(-apiutil.CertificateIssuanceInitialBackoff))),
+	)
+	// a certificate that has failed twice before must wait four hours before
+	// the third failed request is retried
+	exampleCertFailedTwice := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateFailedIssuanceAttempts(2),
+	)
+	exampleCRFailedThreeHoursAgo := gen.CertificateRequestFrom(exampleCRFailed,
+		gen.SetCertificateRequestFailureTime(metav1.NewTime(nowTime.Add(-time.Hour*3))),
+	)
+	exampleCRStale := gen.CertificateRequestFrom(exampleCRPending,
+		func(cr *cmapi.CertificateRequest) { cr.Name = staleCRName },
+	)
+	exampleCRWrongKey := gen.CertificateRequestFrom(exampleCRPending,
+		gen.SetCertificateRequestCSR(generateCSRForCertificate(t, exampleCert, pk2)),
+	)
+
+	createCRAction := testpkg.NewCustomMatch(coretesting.NewCreateAction(
+		cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+		gen.DefaultTestNamespace,
+		exampleCR,
+	), func(exp, act coretesting.Action) error {
+		cr := act.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest)
+		if cr.Name != expectedCRName {
+			return fmt.Errorf("expected certificate request name %q but got %q", expectedCRName, cr.Name)
+		}
+		if cr.Annotations[cmapi.CRPrivateKeyAnnotationKey] != "output" {
+			return fmt.Errorf("expected private key annotation to be set, got %v", cr.Annotations)
+		}
+		if cr.Labels[cmapi.CertificateNameKey] != "test" {
+			return fmt.Errorf("expected certificate name label to be set, got %v", cr.Labels)
+		}
+		if !metav1.IsControlledBy(cr, exampleCert) {
+			return fmt.Errorf("expected certificate request to be owned by certificate")
+		}
+		csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.CSRPEM)
+		if err != nil {
+			return err
+		}
+		matches, err := pki.PublicKeyMatchesCSR(pk1.Public(), csr)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			return fmt.Errorf("expected csr to match private key")
+		}
+		return nil
+	})
+	updateCertStatusAction := testpkg.NewAction(coretesting.NewUpdateAction(
+		cmapi.SchemeGroupVersion.WithResource("certificates"),
+		gen.DefaultTestNamespace,
+		exampleCertTemporaryCondition,
+	))
+
+	tests := map[string]controllerFixture{
+		"should generate and store a new private key if one does not exist": {
+			Issuer:              exampleIssuer,
+			Certificate:         *exampleCert,
+			StaticTemporaryCert: localTempCert,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						exampleCertNotFoundCondition,
+					)),
+					testpkg.NewCustomMatch(coretesting.NewCreateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{},
+					), func(exp, act coretesting.Action) error {
+						secret := act.(coretesting.CreateAction).GetObject().(*corev1.Secret)
+						if _, err := pki.DecodePrivateKeyBytes(secret.Data[corev1.TLSPrivateKeyKey]); err != nil {
+							return err
+						}
+						return nil
+					}),
+				},
+			},
+		},
+		"should create a certificate request if one does not exist": {
+			Issuer:      exampleIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{secretWithTempCert},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					updateCertStatusAction,
+					createCRAction,
+				},
+			},
+		},
+		"should do nothing if the certificate request is pending": {
+			Issuer:      exampleIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{secretWithTempCert},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test"), exampleCRPending},
+				ExpectedActions: []testpkg.Action{
+					updateCertStatusAction,
+				},
+			},
+		},
+		"should store the signed certificate once the certificate request has been issued": {
+			Issuer:      exampleIssuer,
+			Certificate: *gen.CertificateFrom(exampleCertFailedTwice, gen.SetCertificateLastFailureTime(nowMetaTime)),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{secretWithTempCert},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test"), exampleCRIssued},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertTemporaryCondition, gen.SetCertificateRevision(1)),
+					)),
+					testpkg.NewCustomMatch(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{},
+					), func(exp, act coretesting.Action) error {
+						secret := act.(coretesting.UpdateAction).GetObject().(*corev1.Secret)
+						if string(secret.Data[corev1.TLSCertKey]) != string(cert1PEM) {
+							return fmt.Errorf("expected secret to contain the signed certificate")
+						}
+						if string(secret.Data[corev1.TLSPrivateKeyKey]) != string(pk1PEM) {
+							return fmt.Errorf("expected secret to contain the existing private key")
+						}
+						return nil
+					}),
+				},
+			},
+		},
+		"should delete certificate requests that are no longer required and create a new one": {
+			Issuer:      exampleIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{secretWithTempCert},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test"), exampleCRStale},
+				ExpectedActions: []testpkg.Action{
+					updateCertStatusAction,
+					testpkg.NewAction(coretesting.NewDeleteAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						staleCRName,
+					)),
+					createCRAction,
+				},
+			},
+		},
+		"should delete a certificate request with a csr not matching the private key": {
+			Issuer:      exampleIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{secretWithTempCert},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test"), exampleCRWrongKey},
+				ExpectedActions: []testpkg.Action{
+					updateCertStatusAction,
+					testpkg.NewAction(coretesting.NewDeleteAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						expectedCRName,
+					)),
+				},
+			},
+		},
+		"should not delete a recently failed certificate request": {
+			Issuer:      exampleIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{secretWithTempCert},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test"), exampleCRFailed},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertTemporaryCondition,
+							gen.SetCertificateLastFailureTime(nowMetaTime),
+							gen.SetCertificateFailedIssuanceAttempts(1),
+						),
+					)),
+				},
+			},
+		},
+		"should exponentially back-off retrying a certificate that has failed before": {
+			Issuer:      exampleIssuer,
+			Certificate: *exampleCertFailedTwice,
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{secretWithTempCert},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test"), exampleCRFailedThreeHoursAgo},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertTemporaryCondition,
+							gen.SetCertificateLastFailureTime(*exampleCRFailedThreeHoursAgo.Status.FailureTime),
+							gen.SetCertificateFailedIssuanceAttempts(3),
+						),
+					)),
+				},
+			},
+		},
+		"should delete a failed certificate request once the retry period has passed": {
+			Issuer:      exampleIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{secretWithTempCert},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test"), exampleCRFailedExpired},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertTemporaryCondition,
+							gen.SetCertificateFailedIssuanceAttempts(1),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewDeleteAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						expectedCRName,
+					)),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.Builder == nil {
+				test.Builder = &testpkg.Builder{}
+			}
+			test.Clock = fixedClock
+			test.Setup(t)
+			crtCopy := test.Certificate.DeepCopy()
+			err := test.Controller.Sync(test.Ctx, crtCopy)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, crtCopy, err)
+		})
+	}
+}
+
+func TestCertificateRequestNameIgnoresDefaults(t *testing.T) {
+	pk := generatePrivateKey(t)
+	crt := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test"}),
+		gen.SetCertificateSecretName("output"),
+	)
+	defaulted := gen.CertificateFrom(crt,
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test", Kind: cmapi.IssuerKind}),
+		gen.SetCertificateKeyAlgorithm(cmapi.RSAKeyAlgorithm),
+		gen.SetCertificateKeySize(cmapi.DefaultRSAKeySize),
+		gen.SetCertificateKeyEncoding(cmapi.PKCS1),
+	)
+
+	name, err := certificateRequestName(crt, pk, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultedName, err := certificateRequestName(defaulted, pk, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != defaultedName {
+		t.Errorf("expected defaulted Certificate to have CertificateRequest name %q, got %q", name, defaultedName)
+	}
+
+	changedName, err := certificateRequestName(gen.CertificateFrom(defaulted, gen.SetCertificateKeySize(4096)), pk, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changedName == name {
+		t.Errorf("expected CertificateRequest name to change when the key size is changed")
+	}
+}
+
+func TestRequestCertificateReplacesPrivateKey(t *testing.T) {
+	now := time.Now()
+	crt := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateKeyAlgorithm(cmapi.ECDSAKeyAlgorithm),
+		func(crt *cmapi.Certificate) { crt.UID = "test-uid" },
+	)
+
+	pk := generatePrivateKey(t)
+	certPEM := generateSelfSignedCert(t, crt, nil, pk, now, now.Add(time.Hour))
+	tempCertPEM := generateSelfSignedCert(t, crt, big.NewInt(staticTemporarySerialNumber), pk, now, now)
+	secretWithCert := func(cert []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "output", SelfLink: "abc"},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       cert,
+				corev1.TLSPrivateKeyKey: pki.EncodePKCS1PrivateKey(pk),
+			},
+		}
+	}
+	checkGeneratedKey := func(name string, secret *corev1.Secret) error {
+		if secret.Name != name {
+			return fmt.Errorf("expected private key to be stored in secret %q but got %q", name, secret.Name)
+		}
+		key, err := pki.DecodePrivateKeyBytes(secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return err
+		}
+		if !privateKeyMatchesSpec(key, crt) {
+			return fmt.Errorf("expected generated private key to match spec")
+		}
+		return nil
+	}
+
+	tests := map[string]struct {
+		existing        *corev1.Secret
+		expectedActions []testpkg.Action
+	}{
+		"should stage a new private key if a certificate has been issued for the existing private key": {
+			existing: secretWithCert(certPEM),
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					gen.DefaultTestNamespace,
+					&corev1.Secret{},
+				), func(exp, act coretesting.Action) error {
+					return checkGeneratedKey("test-next-private-key", act.(coretesting.CreateAction).GetObject().(*corev1.Secret))
+				}),
+			},
+		},
+		"should replace the existing private key if only a temporary certificate has been issued": {
+			existing: secretWithCert(tempCertPEM),
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewUpdateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					gen.DefaultTestNamespace,
+					&corev1.Secret{},
+				), func(exp, act coretesting.Action) error {
+					return checkGeneratedKey("output", act.(coretesting.UpdateAction).GetObject().(*corev1.Secret))
+				}),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := &controllerFixture{
+				Builder: &testpkg.Builder{
+					KubeObjects:     []runtime.Object{test.existing},
+					ExpectedActions: test.expectedActions,
+				},
+				StaticTemporaryCert: tempCertPEM,
+			}
+			f.Setup(t)
+			defer f.Finish(t)
+
+			if err := f.Controller.requestCertificate(context.Background(), gen.Issuer("test"), crt); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func generateCSRForCertificate(t *testing.T, crt *cmapi.Certificate, pk crypto.Signer) []byte {
+	template, err := pki.GenerateCSR(nil, crt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	derBytes, err := pki.EncodeCSR(template, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: derBytes})
+}
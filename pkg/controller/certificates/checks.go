@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 )
@@ -92,7 +93,15 @@ func (c *Controller) certificatesForSecret(secret *corev1.Secret) ([]*cmapi.Cert
 		if crt.Namespace != secret.Namespace {
 			continue
 		}
-		if crt.Spec.SecretName == secret.Name {
+		if crt.Spec.SecretName == secret.Name || apiutil.NextPrivateKeySecretName(crt) == secret.Name {
+			affected = append(affected, crt)
+			continue
+		}
+		if ref := apiutil.PrivateKeySecretRef(crt); ref != nil && ref.Name == secret.Name {
+			affected = append(affected, crt)
+			continue
+		}
+		if keystoresReferenceSecret(crt, secret.Name) {
 			affected = append(affected, crt)
 		}
 	}
@@ -100,6 +109,19 @@ func (c *Controller) certificatesForSecret(secret *corev1.Secret) ([]*cmapi.Cert
 	return affected, nil
 }
 
+// keystoresReferenceSecret returns true if the password of any of the
+// keystores created for the Certificate is stored in the named Secret.
+func keystoresReferenceSecret(crt *cmapi.Certificate, name string) bool {
+	ks := crt.Spec.Keystores
+	if ks == nil {
+		return false
+	}
+	if ks.PKCS12 != nil && ks.PKCS12.Create && ks.PKCS12.PasswordSecretRef.Name == name {
+		return true
+	}
+	return ks.JKS != nil && ks.JKS.Create && ks.JKS.PasswordSecretRef.Name == name
+}
+
 func (c *Controller) certificatesForGenericIssuer(iss cmapi.GenericIssuer) ([]*cmapi.Certificate, error) {
 	crts, err := c.certificateLister.List(labels.NewSelector())
 
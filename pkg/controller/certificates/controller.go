@@ -24,6 +24,7 @@ import (
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -32,6 +33,7 @@ import (
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
 	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1alpha1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/feature"
 	"github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/metrics"
@@ -55,6 +57,10 @@ type Controller struct {
 	certificateLister   cmlisters.CertificateLister
 	secretLister        corelisters.SecretLister
 
+	// certificateRequestLister is only set if the
+	// CertificateRequestControllers feature is enabled
+	certificateRequestLister cmlisters.CertificateRequestLister
+
 	queue              workqueue.RateLimitingInterface
 	scheduledWorkQueue scheduler.ScheduledWorkQueue
 	workerWg           sync.WaitGroup
@@ -107,6 +113,13 @@ func New(ctx *controllerpkg.Context) *Controller {
 	ordersInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: ctrl.handleOwnedResource})
 	ctrl.syncedFuncs = append(ctrl.syncedFuncs, ordersInformer.Informer().HasSynced)
 
+	if utilfeature.DefaultFeatureGate.Enabled(feature.CertificateRequestControllers) {
+		certificateRequestInformer := ctrl.SharedInformerFactory.Certmanager().V1alpha1().CertificateRequests()
+		certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: ctrl.handleOwnedResource})
+		ctrl.certificateRequestLister = certificateRequestInformer.Lister()
+		ctrl.syncedFuncs = append(ctrl.syncedFuncs, certificateRequestInformer.Informer().HasSynced)
+	}
+
 	ctrl.helper = issuer.NewHelper(ctrl.issuerLister, ctrl.clusterIssuerLister)
 	ctrl.metrics = metrics.Default
 	ctrl.helper = issuer.NewHelper(ctrl.issuerLister, ctrl.clusterIssuerLister)
@@ -197,6 +210,10 @@ func (c *Controller) processNextWorkItem(ctx context.Context, key string) error
 	}
 
 	ctx = logf.NewContext(ctx, logf.WithResource(log, crt))
+	crt, err = c.syncRevokeOnDeleteFinalizer(ctx, crt)
+	if err != nil || crt == nil {
+		return err
+	}
 	return c.Sync(ctx, crt)
 }
 
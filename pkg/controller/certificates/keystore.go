@@ -0,0 +1,188 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// setKeystores will add, update or remove the additional keystore entries
+// in the given secret's data according to the Certificate's keystores
+// configuration. The keystores are always regenerated from the given
+// private key, certificate chain and CA.
+func (c *Controller) setKeystores(crt *v1alpha1.Certificate, secret *corev1.Secret, key crypto.PrivateKey, cert, ca []byte) error {
+	ks := crt.Spec.Keystores
+	createPKCS12 := ks != nil && ks.PKCS12 != nil && ks.PKCS12.Create
+	createJKS := ks != nil && ks.JKS != nil && ks.JKS.Create
+
+	if !createPKCS12 {
+		delete(secret.Data, v1alpha1.PKCS12SecretKey)
+	}
+	if !createJKS {
+		delete(secret.Data, v1alpha1.JKSSecretKey)
+		delete(secret.Data, v1alpha1.JKSTruststoreKey)
+	}
+	if !createPKCS12 && !createJKS {
+		return nil
+	}
+
+	chain, err := pki.DecodeX509CertificateChainBytes(cert)
+	if err != nil {
+		return fmt.Errorf("error decoding certificate chain: %v", err)
+	}
+	var cas []*x509.Certificate
+	if len(ca) > 0 {
+		cas, err = pki.DecodeX509CertificateChainBytes(ca)
+		if err != nil {
+			return fmt.Errorf("error decoding CA certificate: %v", err)
+		}
+	}
+	fullChain := chainWithCAs(chain, cas)
+
+	if createPKCS12 {
+		password, err := c.keystorePassword(crt.Namespace, ks.PKCS12.PasswordSecretRef)
+		if err != nil {
+			return err
+		}
+		secret.Data[v1alpha1.PKCS12SecretKey], err = pki.EncodePKCS12Keystore(password, key, fullChain)
+		if err != nil {
+			return fmt.Errorf("error encoding PKCS#12 keystore: %v", err)
+		}
+	}
+
+	if createJKS {
+		password, err := c.keystorePassword(crt.Namespace, ks.JKS.PasswordSecretRef)
+		if err != nil {
+			return err
+		}
+		secret.Data[v1alpha1.JKSSecretKey], err = pki.EncodeJKSKeystore(password, key, fullChain)
+		if err != nil {
+			return fmt.Errorf("error encoding JKS keystore: %v", err)
+		}
+
+		if len(cas) == 0 {
+			delete(secret.Data, v1alpha1.JKSTruststoreKey)
+			return nil
+		}
+		secret.Data[v1alpha1.JKSTruststoreKey], err = pki.EncodeJKSTruststore(password, cas)
+		if err != nil {
+			return fmt.Errorf("error encoding JKS truststore: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// keystorePassword returns the keystore password stored in the Secret
+// resource referenced by ref.
+func (c *Controller) keystorePassword(namespace string, ref v1alpha1.SecretKeySelector) (string, error) {
+	secret, err := c.secretLister.Secrets(namespace).Get(ref.Name)
+	if err != nil {
+		return "", fmt.Errorf("error getting keystore password secret: %v", err)
+	}
+	password, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("no data for %q in keystore password secret '%s/%s'", ref.Key, namespace, ref.Name)
+	}
+	return string(password), nil
+}
+
+// keystoresMatchSpec returns a list of reasons the keystore entries in the
+// given secret do not match the Certificate's keystores configuration.
+// Keystores are checked for presence, and that they are protected using the
+// password currently stored in the referenced password Secret. Their
+// contents are otherwise regenerated every time the secret is updated.
+func (c *Controller) keystoresMatchSpec(crt *v1alpha1.Certificate, secret *corev1.Secret) []string {
+	ks := crt.Spec.Keystores
+	createPKCS12 := ks != nil && ks.PKCS12 != nil && ks.PKCS12.Create
+	createJKS := ks != nil && ks.JKS != nil && ks.JKS.Create
+
+	expected := map[string]bool{
+		v1alpha1.PKCS12SecretKey:  createPKCS12,
+		v1alpha1.JKSSecretKey:     createJKS,
+		v1alpha1.JKSTruststoreKey: createJKS && len(secret.Data[TLSCAKey]) > 0,
+	}
+
+	var errs []string
+	for _, k := range []string{v1alpha1.PKCS12SecretKey, v1alpha1.JKSSecretKey, v1alpha1.JKSTruststoreKey} {
+		data, exists := secret.Data[k]
+		switch {
+		case expected[k] && !exists:
+			errs = append(errs, fmt.Sprintf("Keystore %q missing from secret", k))
+		case !expected[k] && exists:
+			errs = append(errs, fmt.Sprintf("Keystore %q should not be present in secret", k))
+		case expected[k]:
+			if err := c.keystorePasswordMatches(crt, k, data); err != nil {
+				errs = append(errs, fmt.Sprintf("Keystore %q %v", k, err))
+			}
+		}
+	}
+
+	return errs
+}
+
+// keystorePasswordMatches returns an error if the keystore with the given
+// secret data key is not protected using the password currently stored in
+// its password Secret.
+func (c *Controller) keystorePasswordMatches(crt *v1alpha1.Certificate, key string, data []byte) error {
+	ref := crt.Spec.Keystores.JKS.PasswordSecretRef
+	passwordMatches := pki.PasswordMatchesJKSKeystore
+	if key == v1alpha1.PKCS12SecretKey {
+		ref = crt.Spec.Keystores.PKCS12.PasswordSecretRef
+		passwordMatches = pki.PasswordMatchesPKCS12Keystore
+	}
+
+	password, err := c.keystorePassword(crt.Namespace, ref)
+	if err != nil {
+		return fmt.Errorf("password could not be checked: %v", err)
+	}
+	matches, err := passwordMatches(password, data)
+	if err != nil {
+		return fmt.Errorf("could not be read: %v", err)
+	}
+	if !matches {
+		return fmt.Errorf("is not protected using the current password")
+	}
+	return nil
+}
+
+// chainWithCAs returns the given certificate chain with any of the given CA
+// certificates that are not already part of the chain appended to it.
+func chainWithCAs(chain, cas []*x509.Certificate) []*x509.Certificate {
+	out := append([]*x509.Certificate{}, chain...)
+	for _, ca := range cas {
+		found := false
+		for _, cert := range out {
+			if bytes.Equal(cert.Raw, ca.Raw) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, ca)
+		}
+	}
+	return out
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestSetKeystores(t *testing.T) {
+	passwordSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: gen.DefaultTestNamespace,
+			Name:      "keystore-password",
+		},
+		Data: map[string][]byte{
+			"password": []byte("changeit"),
+		},
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := indexer.Add(passwordSecret); err != nil {
+		t.Fatalf("error adding password secret to indexer: %v", err)
+	}
+	c := &Controller{secretLister: corelisters.NewSecretLister(indexer)}
+
+	passwordRef := cmapi.SecretKeySelector{
+		LocalObjectReference: cmapi.LocalObjectReference{Name: "keystore-password"},
+		Key:                  "password",
+	}
+	crtWithKeystores := func(pkcs12, jks bool, ref cmapi.SecretKeySelector) *cmapi.Certificate {
+		return gen.Certificate("test",
+			gen.SetCertificateDNSNames("example.com"),
+			gen.SetCertificateKeystores(&cmapi.CertificateKeystores{
+				PKCS12: &cmapi.PKCS12Keystore{Create: pkcs12, PasswordSecretRef: ref},
+				JKS:    &cmapi.JKSKeystore{Create: jks, PasswordSecretRef: ref},
+			}),
+		)
+	}
+
+	pk := generatePrivateKey(t)
+	now := time.Now()
+	certPEM := generateSelfSignedCert(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com")), nil, pk, now, now.Add(time.Hour))
+	caPEM := generateSelfSignedCert(t, gen.Certificate("ca", gen.SetCertificateCommonName("ca")), nil, generatePrivateKey(t), now, now.Add(time.Hour))
+
+	tests := map[string]struct {
+		crt          *cmapi.Certificate
+		ca           []byte
+		existingData map[string][]byte
+		expectedKeys []string
+		expectErr    bool
+	}{
+		"should create all keystores": {
+			crt:          crtWithKeystores(true, true, passwordRef),
+			ca:           caPEM,
+			expectedKeys: []string{cmapi.PKCS12SecretKey, cmapi.JKSSecretKey, cmapi.JKSTruststoreKey},
+		},
+		"should not create a truststore if the CA is not known": {
+			crt:          crtWithKeystores(true, true, passwordRef),
+			existingData: map[string][]byte{cmapi.JKSTruststoreKey: []byte("old")},
+			expectedKeys: []string{cmapi.PKCS12SecretKey, cmapi.JKSSecretKey},
+		},
+		"should only create a PKCS#12 keystore": {
+			crt:          crtWithKeystores(true, false, passwordRef),
+			ca:           caPEM,
+			expectedKeys: []string{cmapi.PKCS12SecretKey},
+		},
+		"should remove keystores that are no longer required": {
+			crt: gen.Certificate("test"),
+			ca:  caPEM,
+			existingData: map[string][]byte{
+				cmapi.PKCS12SecretKey:  []byte("old"),
+				cmapi.JKSSecretKey:     []byte("old"),
+				cmapi.JKSTruststoreKey: []byte("old"),
+			},
+		},
+		"should error if the password secret key does not exist": {
+			crt: crtWithKeystores(true, false, cmapi.SecretKeySelector{
+				LocalObjectReference: cmapi.LocalObjectReference{Name: "keystore-password"},
+				Key:                  "missing",
+			}),
+			expectErr: true,
+		},
+		"should error if the password secret does not exist": {
+			crt: crtWithKeystores(false, true, cmapi.SecretKeySelector{
+				LocalObjectReference: cmapi.LocalObjectReference{Name: "missing"},
+				Key:                  "password",
+			}),
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			secret := &corev1.Secret{Data: map[string][]byte{TLSCAKey: test.ca}}
+			for k, v := range test.existingData {
+				secret.Data[k] = v
+			}
+
+			err := c.setKeystores(test.crt, secret, pk, certPEM, test.ca)
+			if err != nil != test.expectErr {
+				t.Fatalf("expected error: %v, got: %v", test.expectErr, err)
+			}
+			if test.expectErr {
+				return
+			}
+
+			if len(secret.Data)-1 != len(test.expectedKeys) {
+				t.Errorf("expected secret data keys %v, got %d keys", test.expectedKeys, len(secret.Data))
+			}
+			for _, k := range test.expectedKeys {
+				if len(secret.Data[k]) == 0 || bytes.Equal(secret.Data[k], []byte("old")) {
+					t.Errorf("expected %q to be set in secret data", k)
+				}
+			}
+
+			if errs := c.keystoresMatchSpec(test.crt, secret); len(errs) > 0 {
+				t.Errorf("expected keystores to match spec, got: %v", errs)
+			}
+		})
+	}
+}
+
+func TestKeystoresMatchSpec(t *testing.T) {
+	passwordSecret := func(name, password string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: gen.DefaultTestNamespace,
+				Name:      name,
+			},
+			Data: map[string][]byte{"password": []byte(password)},
+		}
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, s := range []*corev1.Secret{passwordSecret("keystore-password", "changeit"), passwordSecret("new-password", "changed")} {
+		if err := indexer.Add(s); err != nil {
+			t.Fatalf("error adding password secret to indexer: %v", err)
+		}
+	}
+	c := &Controller{secretLister: corelisters.NewSecretLister(indexer)}
+
+	crtWithJKS := func(passwordSecretName string) *cmapi.Certificate {
+		return gen.Certificate("test",
+			gen.SetCertificateKeystores(&cmapi.CertificateKeystores{
+				JKS: &cmapi.JKSKeystore{
+					Create: true,
+					PasswordSecretRef: cmapi.SecretKeySelector{
+						LocalObjectReference: cmapi.LocalObjectReference{Name: passwordSecretName},
+						Key:                  "password",
+					},
+				},
+			}),
+		)
+	}
+	crt := crtWithJKS("keystore-password")
+
+	pk := generatePrivateKey(t)
+	now := time.Now()
+	certPEM := generateSelfSignedCert(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com")), nil, pk, now, now.Add(time.Hour))
+	caPEM := generateSelfSignedCert(t, gen.Certificate("ca", gen.SetCertificateCommonName("ca")), nil, generatePrivateKey(t), now, now.Add(time.Hour))
+	existing := &corev1.Secret{Data: map[string][]byte{TLSCAKey: caPEM}}
+	if err := c.setKeystores(crt, existing, pk, certPEM, caPEM); err != nil {
+		t.Fatalf("error creating keystores: %v", err)
+	}
+	keystore, truststore := existing.Data[cmapi.JKSSecretKey], existing.Data[cmapi.JKSTruststoreKey]
+
+	tests := map[string]struct {
+		crt          *cmapi.Certificate
+		data         map[string][]byte
+		expectedErrs int
+	}{
+		"matches with jks keystore and truststore": {
+			crt: crt,
+			data: map[string][]byte{
+				TLSCAKey:               caPEM,
+				cmapi.JKSSecretKey:     keystore,
+				cmapi.JKSTruststoreKey: truststore,
+			},
+		},
+		"matches with jks keystore and no CA": {
+			crt:  crt,
+			data: map[string][]byte{cmapi.JKSSecretKey: keystore},
+		},
+		"missing jks keystore and truststore": {
+			crt:          crt,
+			data:         map[string][]byte{TLSCAKey: caPEM},
+			expectedErrs: 2,
+		},
+		"unexpected pkcs12 keystore": {
+			crt: crt,
+			data: map[string][]byte{
+				cmapi.JKSSecretKey:    keystore,
+				cmapi.PKCS12SecretKey: []byte("keystore"),
+			},
+			expectedErrs: 1,
+		},
+		"no keystores configured": {
+			crt:  gen.Certificate("test"),
+			data: map[string][]byte{TLSCAKey: caPEM},
+		},
+		"keystore and truststore protected using a different password": {
+			crt: crtWithJKS("new-password"),
+			data: map[string][]byte{
+				TLSCAKey:               caPEM,
+				cmapi.JKSSecretKey:     keystore,
+				cmapi.JKSTruststoreKey: truststore,
+			},
+			expectedErrs: 2,
+		},
+		"keystore that cannot be read": {
+			crt:          crt,
+			data:         map[string][]byte{cmapi.JKSSecretKey: []byte("keystore")},
+			expectedErrs: 1,
+		},
+		"password secret does not exist": {
+			crt:          crtWithJKS("missing"),
+			data:         map[string][]byte{cmapi.JKSSecretKey: keystore},
+			expectedErrs: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs := c.keystoresMatchSpec(test.crt, &corev1.Secret{Data: test.data})
+			if len(errs) != test.expectedErrs {
+				t.Errorf("expected %d errors, got: %v", test.expectedErrs, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"encoding/pem"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+// additionalOutputFormatKeys maps each additional output format to the name
+// of the data entry it is stored in.
+var additionalOutputFormatKeys = map[v1alpha1.CertificateOutputFormatType]string{
+	v1alpha1.CertificateOutputFormatDER:         v1alpha1.CertificateOutputFormatDERKey,
+	v1alpha1.CertificateOutputFormatCombinedPEM: v1alpha1.CertificateOutputFormatCombinedPEMKey,
+}
+
+// additionalOutputFormatData returns the secret data entries for each of the
+// additional output formats requested on the Certificate, generated from the
+// given PEM encoded private key and certificate chain.
+func additionalOutputFormatData(crt *v1alpha1.Certificate, key, cert []byte) (map[string][]byte, error) {
+	data := make(map[string][]byte)
+	for _, f := range crt.Spec.AdditionalOutputFormats {
+		switch f.Type {
+		case v1alpha1.CertificateOutputFormatDER:
+			block, _ := pem.Decode(key)
+			if block == nil {
+				return nil, fmt.Errorf("error decoding private key PEM block")
+			}
+			data[v1alpha1.CertificateOutputFormatDERKey] = block.Bytes
+		case v1alpha1.CertificateOutputFormatCombinedPEM:
+			combined := make([]byte, 0, len(key)+len(cert))
+			combined = append(combined, key...)
+			combined = append(combined, cert...)
+			data[v1alpha1.CertificateOutputFormatCombinedPEMKey] = combined
+		default:
+			return nil, fmt.Errorf("unknown additional output format %q", f.Type)
+		}
+	}
+	return data, nil
+}
+
+// setAdditionalOutputFormats will add, update or remove the additional output
+// format entries in the given secret's data according to the Certificate's
+// additionalOutputFormats, so that they are always consistent with the given
+// PEM encoded private key and certificate chain.
+func setAdditionalOutputFormats(crt *v1alpha1.Certificate, secret *corev1.Secret, key, cert []byte) error {
+	data, err := additionalOutputFormatData(crt, key, cert)
+	if err != nil {
+		return err
+	}
+	for _, k := range additionalOutputFormatKeys {
+		delete(secret.Data, k)
+	}
+	for k, v := range data {
+		secret.Data[k] = v
+	}
+	return nil
+}
+
+// additionalOutputFormatsMatchSpec returns a list of reasons the additional
+// output format entries in the given secret do not match the Certificate's
+// additionalOutputFormats, or are not consistent with the private key and
+// certificate stored in the secret.
+func additionalOutputFormatsMatchSpec(crt *v1alpha1.Certificate, secret *corev1.Secret) []string {
+	expected, err := additionalOutputFormatData(crt, secret.Data[corev1.TLSPrivateKeyKey], secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		// an invalid private key will be handled when the certificate is
+		// next checked, so there is nothing to update
+		return nil
+	}
+
+	var errs []string
+	for _, t := range []v1alpha1.CertificateOutputFormatType{v1alpha1.CertificateOutputFormatDER, v1alpha1.CertificateOutputFormatCombinedPEM} {
+		k := additionalOutputFormatKeys[t]
+		want, wanted := expected[k]
+		got, exists := secret.Data[k]
+		switch {
+		case wanted && !exists:
+			errs = append(errs, fmt.Sprintf("Output format %q missing from secret", t))
+		case !wanted && exists:
+			errs = append(errs, fmt.Sprintf("Output format %q should not be present in secret", t))
+		case wanted && !bytes.Equal(want, got):
+			errs = append(errs, fmt.Sprintf("Output format %q does not match the certificate and private key", t))
+		}
+	}
+
+	return errs
+}
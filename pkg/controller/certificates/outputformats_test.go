@@ -0,0 +1,175 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestSetAdditionalOutputFormats(t *testing.T) {
+	pk := generatePrivateKey(t)
+	pkPEM := pki.EncodePKCS1PrivateKey(pk)
+	now := time.Now()
+	certPEM := generateSelfSignedCert(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com")), nil, pk, now, now.Add(time.Hour))
+
+	tests := map[string]struct {
+		crt          *cmapi.Certificate
+		existingData map[string][]byte
+		expectedKeys []string
+	}{
+		"should write all additional output formats": {
+			crt: gen.Certificate("test",
+				gen.SetCertificateAdditionalOutputFormats(cmapi.CertificateOutputFormatDER, cmapi.CertificateOutputFormatCombinedPEM),
+			),
+			expectedKeys: []string{cmapi.CertificateOutputFormatDERKey, cmapi.CertificateOutputFormatCombinedPEMKey},
+		},
+		"should only write the DER private key": {
+			crt: gen.Certificate("test",
+				gen.SetCertificateAdditionalOutputFormats(cmapi.CertificateOutputFormatDER),
+			),
+			existingData: map[string][]byte{cmapi.CertificateOutputFormatCombinedPEMKey: []byte("old")},
+			expectedKeys: []string{cmapi.CertificateOutputFormatDERKey},
+		},
+		"should replace stale additional output formats": {
+			crt: gen.Certificate("test",
+				gen.SetCertificateAdditionalOutputFormats(cmapi.CertificateOutputFormatCombinedPEM),
+			),
+			existingData: map[string][]byte{cmapi.CertificateOutputFormatCombinedPEMKey: []byte("old")},
+			expectedKeys: []string{cmapi.CertificateOutputFormatCombinedPEMKey},
+		},
+		"should remove additional output formats that are no longer required": {
+			crt: gen.Certificate("test"),
+			existingData: map[string][]byte{
+				cmapi.CertificateOutputFormatDERKey:         []byte("old"),
+				cmapi.CertificateOutputFormatCombinedPEMKey: []byte("old"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			secret := &corev1.Secret{Data: map[string][]byte{
+				corev1.TLSCertKey:       certPEM,
+				corev1.TLSPrivateKeyKey: pkPEM,
+			}}
+			for k, v := range test.existingData {
+				secret.Data[k] = v
+			}
+
+			if err := setAdditionalOutputFormats(test.crt, secret, pkPEM, certPEM); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(secret.Data)-2 != len(test.expectedKeys) {
+				t.Errorf("expected secret data keys %v, got %d keys", test.expectedKeys, len(secret.Data))
+			}
+			for _, k := range test.expectedKeys {
+				if len(secret.Data[k]) == 0 || bytes.Equal(secret.Data[k], []byte("old")) {
+					t.Errorf("expected %q to be set in secret data", k)
+				}
+			}
+
+			if der, ok := secret.Data[cmapi.CertificateOutputFormatDERKey]; ok {
+				if _, err := x509.ParsePKCS1PrivateKey(der); err != nil {
+					t.Errorf("expected DER private key to be valid: %v", err)
+				}
+			}
+			if combined, ok := secret.Data[cmapi.CertificateOutputFormatCombinedPEMKey]; ok {
+				if _, err := pki.DecodePrivateKeyBytes(combined); err != nil {
+					t.Errorf("expected combined PEM to contain the private key: %v", err)
+				}
+				_, rest := pem.Decode(combined)
+				if _, err := pki.DecodeX509CertificateBytes(rest); err != nil {
+					t.Errorf("expected combined PEM to contain the certificate after the private key: %v", err)
+				}
+			}
+
+			if errs := additionalOutputFormatsMatchSpec(test.crt, secret); len(errs) > 0 {
+				t.Errorf("expected additional output formats to match spec, got: %v", errs)
+			}
+		})
+	}
+}
+
+func TestAdditionalOutputFormatsMatchSpec(t *testing.T) {
+	pk := generatePrivateKey(t)
+	pkPEM := pki.EncodePKCS1PrivateKey(pk)
+	now := time.Now()
+	certPEM := generateSelfSignedCert(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com")), nil, pk, now, now.Add(time.Hour))
+	combinedPEM := append(append([]byte{}, pkPEM...), certPEM...)
+
+	crt := gen.Certificate("test",
+		gen.SetCertificateAdditionalOutputFormats(cmapi.CertificateOutputFormatCombinedPEM),
+	)
+
+	tests := map[string]struct {
+		crt          *cmapi.Certificate
+		data         map[string][]byte
+		expectedErrs int
+	}{
+		"matches with an up to date combined PEM": {
+			crt:  crt,
+			data: map[string][]byte{cmapi.CertificateOutputFormatCombinedPEMKey: combinedPEM},
+		},
+		"missing combined PEM": {
+			crt:          crt,
+			expectedErrs: 1,
+		},
+		"combined PEM not matching the certificate": {
+			crt:          crt,
+			data:         map[string][]byte{cmapi.CertificateOutputFormatCombinedPEMKey: []byte("old")},
+			expectedErrs: 1,
+		},
+		"unexpected DER private key": {
+			crt: crt,
+			data: map[string][]byte{
+				cmapi.CertificateOutputFormatCombinedPEMKey: combinedPEM,
+				cmapi.CertificateOutputFormatDERKey:         []byte("der"),
+			},
+			expectedErrs: 1,
+		},
+		"no additional output formats configured": {
+			crt: gen.Certificate("test"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			secret := &corev1.Secret{Data: map[string][]byte{
+				corev1.TLSCertKey:       certPEM,
+				corev1.TLSPrivateKeyKey: pkPEM,
+			}}
+			for k, v := range test.data {
+				secret.Data[k] = v
+			}
+			errs := additionalOutputFormatsMatchSpec(test.crt, secret)
+			if len(errs) != test.expectedErrs {
+				t.Errorf("expected %d errors, got: %v", test.expectedErrs, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+package certificates
+
+import (
+	"context"
+	"crypto"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/errors"
+	"github.com/jetstack/cert-manager/pkg/util/kube"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	reasonPrivateKeyNotFound = "PrivateKeyNotFound"
+	reasonPrivateKeyMismatch = "PrivateKeyMismatch"
+)
+
+// ensureProvidedPrivateKey ensures that the private key stored in the Secret
+// referenced by the Certificate's privateKey.secretRef is stored in the
+// Certificate's target secret, so that issuers use it instead of generating
+// a new private key. ready will be true if the target secret already
+// contains the provided private key and issuance can continue.
+// If the provided private key cannot be read, or does not match the key
+// algorithm and size of the Certificate, an event is recorded and ready will
+// be false. The Certificate will be re-synced once the referenced Secret is
+// updated.
+func (c *Controller) ensureProvidedPrivateKey(ctx context.Context, crt *v1alpha1.Certificate) (ready bool, err error) {
+	log := logf.FromContext(ctx)
+	ref := apiutil.PrivateKeySecretRef(crt)
+
+	key, err := kube.SecretTLSKeyRef(ctx, c.secretLister, crt.Namespace, ref.Name, ref.Key)
+	if k8sErrors.IsNotFound(err) || errors.IsInvalidData(err) {
+		c.Recorder.Eventf(crt, corev1.EventTypeWarning, reasonPrivateKeyNotFound, "Error reading private key from key %q of Secret %q: %v", ref.Key, ref.Name, err)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !privateKeyMatchesSpec(key, crt) {
+		c.Recorder.Eventf(crt, corev1.EventTypeWarning, reasonPrivateKeyMismatch, "Private key in Secret %q does not match the key algorithm and size of the Certificate", ref.Name)
+		return false, nil
+	}
+
+	existingKey, err := kube.SecretTLSKey(ctx, c.secretLister, crt.Namespace, crt.Spec.SecretName)
+	if err != nil && !k8sErrors.IsNotFound(err) && !errors.IsInvalidData(err) {
+		return false, err
+	}
+	if err == nil {
+		equal, err := pki.PublicKeysEqual(existingKey.Public(), key.Public())
+		if err != nil {
+			return false, err
+		}
+		if equal {
+			return true, nil
+		}
+	}
+
+	keyPEM, err := pki.EncodePrivateKey(key)
+	if err != nil {
+		return false, err
+	}
+
+	// the update to the Secret will trigger the Certificate to be re-synced,
+	// at which point the provided private key will be used for issuance
+	log.Info("storing provided private key in target secret")
+	if _, err := c.updateSecret(ctx, crt, crt.Namespace, nil, keyPEM, nil); err != nil {
+		log.Error(err, "error saving private key")
+		c.Recorder.Event(crt, corev1.EventTypeWarning, errorSavingCertificate, messageErrorSavingCertificate+err.Error())
+		return false, err
+	}
+
+	return false, nil
+}
+
+// providedPrivateKeyMatches returns false if the Certificate uses a provided
+// private key, and the given private key is not the same as the key
+// currently stored in the Secret referenced by privateKey.secretRef.
+func (c *Controller) providedPrivateKeyMatches(ctx context.Context, crt *v1alpha1.Certificate, key crypto.Signer) bool {
+	ref := apiutil.PrivateKeySecretRef(crt)
+	if ref == nil {
+		return true
+	}
+	provided, err := kube.SecretTLSKeyRef(ctx, c.secretLister, crt.Namespace, ref.Name, ref.Key)
+	if err != nil {
+		// errors reading the provided private key are reported when
+		// attempting to issue a certificate
+		return true
+	}
+	equal, err := pki.PublicKeysEqual(provided.Public(), key.Public())
+	return err != nil || equal
+}
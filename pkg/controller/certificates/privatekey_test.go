@@ -0,0 +1,157 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestEnsureProvidedPrivateKey(t *testing.T) {
+	now := time.Now()
+	crt := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateSecretName("output"),
+		func(crt *cmapi.Certificate) {
+			crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{
+				SecretRef: &cmapi.SecretKeySelector{
+					LocalObjectReference: cmapi.LocalObjectReference{Name: "escrowed"},
+					Key:                  "key.pem",
+				},
+			}
+		},
+	)
+
+	pk := generatePrivateKey(t)
+	pkPEM := pki.EncodePKCS1PrivateKey(pk)
+	otherPKPEM := pki.EncodePKCS1PrivateKey(generatePrivateKey(t))
+	ecPK, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecPKPEM, err := pki.EncodeECPrivateKey(ecPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tempCertPEM := generateSelfSignedCert(t, crt, big.NewInt(staticTemporarySerialNumber), pk, now, now)
+
+	secret := func(name, key string, data []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: name, SelfLink: "/" + name},
+			Data:       map[string][]byte{key: data},
+		}
+	}
+	checkStoredKey := func(secret *corev1.Secret) error {
+		if secret.Name != "output" {
+			return fmt.Errorf("expected the target secret to be written but got %q", secret.Name)
+		}
+		key, err := pki.DecodePrivateKeyBytes(secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return err
+		}
+		equal, err := pki.PublicKeysEqual(key.Public(), pk.Public())
+		if err != nil {
+			return err
+		}
+		if !equal {
+			return fmt.Errorf("expected the provided private key to be stored in the target secret")
+		}
+		return nil
+	}
+
+	tests := map[string]struct {
+		existing        []runtime.Object
+		expectedActions []testpkg.Action
+		expectedReady   bool
+	}{
+		"should not be ready if the referenced secret does not exist": {},
+		"should not be ready if the referenced secret does not contain the key": {
+			existing: []runtime.Object{secret("escrowed", corev1.TLSPrivateKeyKey, pkPEM)},
+		},
+		"should not be ready if the provided key does not match the spec": {
+			existing: []runtime.Object{secret("escrowed", "key.pem", ecPKPEM)},
+		},
+		"should store the provided key if the target secret does not exist": {
+			existing: []runtime.Object{secret("escrowed", "key.pem", pkPEM)},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					gen.DefaultTestNamespace,
+					&corev1.Secret{},
+				), func(exp, act coretesting.Action) error {
+					return checkStoredKey(act.(coretesting.CreateAction).GetObject().(*corev1.Secret))
+				}),
+			},
+		},
+		"should replace a different private key in the target secret": {
+			existing: []runtime.Object{
+				secret("escrowed", "key.pem", pkPEM),
+				secret("output", corev1.TLSPrivateKeyKey, otherPKPEM),
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewUpdateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					gen.DefaultTestNamespace,
+					&corev1.Secret{},
+				), func(exp, act coretesting.Action) error {
+					return checkStoredKey(act.(coretesting.UpdateAction).GetObject().(*corev1.Secret))
+				}),
+			},
+		},
+		"should be ready if the target secret contains the provided key": {
+			existing: []runtime.Object{
+				secret("escrowed", "key.pem", pkPEM),
+				secret("output", corev1.TLSPrivateKeyKey, pkPEM),
+			},
+			expectedReady: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				KubeObjects:     test.existing,
+				ExpectedActions: test.expectedActions,
+			}
+			f := &controllerFixture{Builder: builder, StaticTemporaryCert: tempCertPEM}
+			f.Setup(t)
+			defer f.Finish(t)
+
+			ready, err := f.Controller.ensureProvidedPrivateKey(context.Background(), crt)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ready != test.expectedReady {
+				t.Errorf("expected ready to be %t but got %t", test.expectedReady, ready)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const reasonRevoked = "Revoked"
+
+// syncRevokeOnDeleteFinalizer ensures that a Certificate carries the
+// RevokeOnDeleteFinalizer if, and only if, its issuer is a CA issuer
+// configured to revoke certificates on deletion. Once a Certificate carrying
+// the finalizer is deleted, its certificate is revoked before the finalizer is
+// removed. It returns the Certificate to be synced, or nil if the Certificate
+// is being deleted and should not be synced any further.
+func (c *Controller) syncRevokeOnDeleteFinalizer(ctx context.Context, crt *cmapi.Certificate) (*cmapi.Certificate, error) {
+	log := logf.FromContext(ctx)
+	hasFinalizer := hasRevokeOnDeleteFinalizer(crt)
+
+	if crt.DeletionTimestamp != nil {
+		if !hasFinalizer {
+			return crt, nil
+		}
+		if err := c.revokeDeletedCertificate(ctx, crt); err != nil {
+			return nil, err
+		}
+		crt = crt.DeepCopy()
+		crt.Finalizers = removeRevokeOnDeleteFinalizer(crt.Finalizers)
+		if _, err := c.CMClient.CertmanagerV1alpha1().Certificates(crt.Namespace).Update(crt); err != nil {
+			return nil, err
+		}
+		log.V(logf.DebugLevel).Info("removed revoke on delete finalizer")
+		return nil, nil
+	}
+
+	iss, err := c.helper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if err != nil {
+		// errors getting the issuer are reported when syncing the Certificate
+		return crt, nil
+	}
+	if revokeOnDelete(iss) == hasFinalizer {
+		return crt, nil
+	}
+
+	crt = crt.DeepCopy()
+	if hasFinalizer {
+		crt.Finalizers = removeRevokeOnDeleteFinalizer(crt.Finalizers)
+	} else {
+		crt.Finalizers = append(crt.Finalizers, cmapi.RevokeOnDeleteFinalizer)
+	}
+	return c.CMClient.CertmanagerV1alpha1().Certificates(crt.Namespace).Update(crt)
+}
+
+func hasRevokeOnDeleteFinalizer(crt *cmapi.Certificate) bool {
+	for _, f := range crt.Finalizers {
+		if f == cmapi.RevokeOnDeleteFinalizer {
+			return true
+		}
+	}
+	return false
+}
+
+func removeRevokeOnDeleteFinalizer(finalizers []string) []string {
+	var remaining []string
+	for _, f := range finalizers {
+		if f != cmapi.RevokeOnDeleteFinalizer {
+			remaining = append(remaining, f)
+		}
+	}
+	return remaining
+}
+
+// revokeDeletedCertificate records the revocation of the current certificate
+// of a deleted Certificate in the status of its issuer, if that issuer is a
+// CA issuer configured to revoke certificates on deletion. It only returns nil
+// once the revocation has been persisted or is not required.
+func (c *Controller) revokeDeletedCertificate(ctx context.Context, crt *cmapi.Certificate) error {
+	log := logf.FromContext(ctx)
+
+	if crt.Status.SerialNumber == "" {
+		return nil
+	}
+
+	iss, err := c.helper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if k8sErrors.IsNotFound(err) {
+		// there is nothing to record the revocation in, so don't block the
+		// deletion of the Certificate
+		log.Info("issuer of deleted Certificate not found, not revoking certificate")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !revokeOnDelete(iss) {
+		return nil
+	}
+
+	log = logf.WithRelatedResource(log, iss).WithValues("serial_number", crt.Status.SerialNumber)
+
+	revoked := false
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		switch iss.(type) {
+		case *cmapi.Issuer:
+			latest, err := c.CMClient.CertmanagerV1alpha1().Issuers(iss.GetObjectMeta().Namespace).Get(iss.GetObjectMeta().Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if !revokeOnDelete(latest) {
+				return nil
+			}
+			if revoked = apiutil.RevokeCertificate(latest, crt.Status.SerialNumber, metav1.NewTime(c.clock.Now()), crt.Status.NotAfter); !revoked {
+				return nil
+			}
+			_, err = c.CMClient.CertmanagerV1alpha1().Issuers(latest.Namespace).Update(latest)
+			return err
+		case *cmapi.ClusterIssuer:
+			latest, err := c.CMClient.CertmanagerV1alpha1().ClusterIssuers().Get(iss.GetObjectMeta().Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if !revokeOnDelete(latest) {
+				return nil
+			}
+			if revoked = apiutil.RevokeCertificate(latest, crt.Status.SerialNumber, metav1.NewTime(c.clock.Now()), crt.Status.NotAfter); !revoked {
+				return nil
+			}
+			_, err = c.CMClient.CertmanagerV1alpha1().ClusterIssuers().Update(latest)
+			return err
+		}
+		return nil
+	})
+	if k8sErrors.IsNotFound(err) {
+		log.Info("issuer of deleted Certificate not found, not revoking certificate")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if revoked {
+		log.Info("revoked certificate of deleted Certificate")
+		c.Recorder.Eventf(iss, corev1.EventTypeNormal, reasonRevoked,
+			"Revoked certificate with serial number %s of deleted Certificate %s/%s", crt.Status.SerialNumber, crt.Namespace, crt.Name)
+	}
+
+	return nil
+}
+
+func revokeOnDelete(iss cmapi.GenericIssuer) bool {
+	ca := iss.GetSpec().CA
+	return ca != nil && ca.CRL != nil && ca.CRL.RevokeOnDelete
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestRevokeDeletedCertificate(t *testing.T) {
+	caIssuer := func(revokeOnDelete bool) gen.IssuerModifier {
+		return gen.SetIssuerCA(cmapi.CAIssuer{
+			SecretName: "ca",
+			CRL:        &cmapi.CACRLConfig{SecretName: "ca-crl", RevokeOnDelete: revokeOnDelete},
+		})
+	}
+	revoked := func(serials ...string) gen.IssuerModifier {
+		return func(iss cmapi.GenericIssuer) {
+			iss.GetStatus().CA = &cmapi.CAIssuerStatus{}
+			for _, s := range serials {
+				iss.GetStatus().CA.RevokedCertificates = append(iss.GetStatus().CA.RevokedCertificates, cmapi.RevokedCertificate{SerialNumber: s})
+			}
+		}
+	}
+	issuer := gen.Issuer("ca", caIssuer(true))
+	clusterIssuer := gen.ClusterIssuer("ca", caIssuer(true))
+
+	crt := gen.Certificate("test",
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "ca"}),
+		gen.SetCertificateSerialNumber("0B"),
+	)
+
+	issuersResource := cmapi.SchemeGroupVersion.WithResource("issuers")
+	clusterIssuersResource := cmapi.SchemeGroupVersion.WithResource("clusterissuers")
+	expectRevoked := func(serials ...string) testpkg.ActionMatchFn {
+		return func(exp, act coretesting.Action) error {
+			iss := act.(coretesting.UpdateAction).GetObject().(cmapi.GenericIssuer)
+			var got []string
+			if iss.GetStatus().CA != nil {
+				for _, r := range iss.GetStatus().CA.RevokedCertificates {
+					got = append(got, r.SerialNumber)
+				}
+			}
+			if fmt.Sprint(got) != fmt.Sprint(serials) {
+				return fmt.Errorf("expected revoked serial numbers %v, got %v", serials, got)
+			}
+			return nil
+		}
+	}
+
+	tests := map[string]struct {
+		issuer          cmapi.GenericIssuer
+		crt             *cmapi.Certificate
+		expectedActions []testpkg.Action
+	}{
+		"revoke the certificate in the status of an Issuer": {
+			issuer: gen.IssuerFrom(issuer.DeepCopy(), revoked("0A")),
+			crt:    crt,
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewGetAction(issuersResource, gen.DefaultTestNamespace, "ca")),
+				testpkg.NewCustomMatch(coretesting.NewUpdateAction(issuersResource, gen.DefaultTestNamespace, nil),
+					expectRevoked("0A", "0B")),
+			},
+		},
+		"revoke the certificate in the status of a ClusterIssuer": {
+			issuer: clusterIssuer,
+			crt:    crt,
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewRootGetAction(clusterIssuersResource, "ca")),
+				testpkg.NewCustomMatch(coretesting.NewRootUpdateAction(clusterIssuersResource, nil),
+					expectRevoked("0B")),
+			},
+		},
+		"do not update the issuer if the certificate has already been revoked": {
+			issuer: gen.IssuerFrom(issuer.DeepCopy(), revoked("0B")),
+			crt:    crt,
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewGetAction(issuersResource, gen.DefaultTestNamespace, "ca")),
+			},
+		},
+		"do nothing if revokeOnDelete is not set": {
+			issuer: gen.Issuer("ca", caIssuer(false)),
+			crt:    crt,
+		},
+		"do nothing if the certificate has not been issued": {
+			issuer: issuer,
+			crt:    gen.CertificateFrom(crt.DeepCopy(), gen.SetCertificateSerialNumber("")),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := &controllerFixture{
+				Issuer: test.issuer,
+				Clock:  fakeclock.NewFakeClock(time.Now()),
+				Builder: &testpkg.Builder{
+					CertManagerObjects: []runtime.Object{test.issuer},
+					ExpectedActions:    test.expectedActions,
+				},
+			}
+			f.Setup(t)
+			defer f.Finish(t)
+
+			if err := f.Controller.revokeDeletedCertificate(context.Background(), test.crt); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestSyncRevokeOnDeleteFinalizer(t *testing.T) {
+	revokingIssuer := gen.Issuer("ca", gen.SetIssuerCA(cmapi.CAIssuer{
+		SecretName: "ca",
+		CRL:        &cmapi.CACRLConfig{SecretName: "ca-crl", RevokeOnDelete: true},
+	}))
+	issuer := gen.Issuer("ca", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca"}))
+
+	notAfter := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+	crt := gen.Certificate("test",
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "ca"}),
+		gen.SetCertificateSerialNumber("0B"),
+		gen.SetCertificateNotAfter(notAfter),
+	)
+	withFinalizer := func(crt *cmapi.Certificate) {
+		crt.Finalizers = append(crt.Finalizers, cmapi.RevokeOnDeleteFinalizer)
+	}
+	deleted := func(crt *cmapi.Certificate) {
+		now := metav1.Now()
+		crt.DeletionTimestamp = &now
+	}
+
+	issuersResource := cmapi.SchemeGroupVersion.WithResource("issuers")
+	certificatesResource := cmapi.SchemeGroupVersion.WithResource("certificates")
+	expectFinalizer := func(expected bool) testpkg.ActionMatchFn {
+		return func(exp, act coretesting.Action) error {
+			crt := act.(coretesting.UpdateAction).GetObject().(*cmapi.Certificate)
+			if hasRevokeOnDeleteFinalizer(crt) != expected {
+				return fmt.Errorf("expected finalizer to be present %t, got finalizers %v", expected, crt.Finalizers)
+			}
+			return nil
+		}
+	}
+	expectRevokedUntil := func(exp, act coretesting.Action) error {
+		iss := act.(coretesting.UpdateAction).GetObject().(*cmapi.Issuer)
+		revoked := iss.Status.CA.RevokedCertificates
+		if len(revoked) != 1 || revoked[0].SerialNumber != "0B" {
+			return fmt.Errorf("expected serial number 0B to be revoked, got %v", revoked)
+		}
+		if revoked[0].NotAfter == nil || !revoked[0].NotAfter.Equal(&notAfter) {
+			return fmt.Errorf("expected revoked certificate to expire at %v, got %v", notAfter, revoked[0].NotAfter)
+		}
+		return nil
+	}
+
+	tests := map[string]struct {
+		issuer          cmapi.GenericIssuer
+		crt             *cmapi.Certificate
+		expectSync      bool
+		expectedActions []testpkg.Action
+	}{
+		"add the finalizer if the issuer revokes certificates on deletion": {
+			issuer:     revokingIssuer,
+			crt:        crt,
+			expectSync: true,
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewUpdateAction(certificatesResource, gen.DefaultTestNamespace, nil),
+					expectFinalizer(true)),
+			},
+		},
+		"remove the finalizer if the issuer no longer revokes certificates on deletion": {
+			issuer:     issuer,
+			crt:        gen.CertificateFrom(crt.DeepCopy(), withFinalizer),
+			expectSync: true,
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewUpdateAction(certificatesResource, gen.DefaultTestNamespace, nil),
+					expectFinalizer(false)),
+			},
+		},
+		"do nothing if the finalizer is already present": {
+			issuer:     revokingIssuer,
+			crt:        gen.CertificateFrom(crt.DeepCopy(), withFinalizer),
+			expectSync: true,
+		},
+		"do nothing if the issuer does not revoke certificates on deletion": {
+			issuer:     issuer,
+			crt:        crt,
+			expectSync: true,
+		},
+		"revoke the certificate before removing the finalizer on deletion": {
+			issuer: revokingIssuer,
+			crt:    gen.CertificateFrom(crt.DeepCopy(), withFinalizer, deleted),
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewGetAction(issuersResource, gen.DefaultTestNamespace, "ca")),
+				testpkg.NewCustomMatch(coretesting.NewUpdateAction(issuersResource, gen.DefaultTestNamespace, nil),
+					expectRevokedUntil),
+				testpkg.NewCustomMatch(coretesting.NewUpdateAction(certificatesResource, gen.DefaultTestNamespace, nil),
+					expectFinalizer(false)),
+			},
+		},
+		"sync a deleted Certificate without the finalizer as usual": {
+			issuer:     revokingIssuer,
+			crt:        gen.CertificateFrom(crt.DeepCopy(), deleted),
+			expectSync: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := &controllerFixture{
+				Issuer: test.issuer,
+				Clock:  fakeclock.NewFakeClock(time.Now()),
+				Builder: &testpkg.Builder{
+					CertManagerObjects: []runtime.Object{test.issuer, test.crt},
+					ExpectedActions:    test.expectedActions,
+				},
+			}
+			f.Setup(t)
+			defer f.Finish(t)
+
+			got, err := f.Controller.syncRevokeOnDeleteFinalizer(context.Background(), test.crt)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if (got != nil) != test.expectSync {
+				t.Errorf("expected Certificate to be synced %t, got %v", test.expectSync, got)
+			}
+		})
+	}
+}
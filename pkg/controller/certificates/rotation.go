@@ -0,0 +1,164 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	reasonGeneratedKey = "GeneratedKey"
+)
+
+// privateKeyRotationRequired returns true if a new private key should be
+// used when issuing the given Certificate. This is the case if the
+// Certificate's rotation policy is Always and its target secret already
+// contains a certificate issued for the existing private key. If no
+// certificate has been issued yet, the issuer will generate a new private key
+// if required anyway.
+func (c *Controller) privateKeyRotationRequired(crt *v1alpha1.Certificate) (bool, error) {
+	if apiutil.PrivateKeyRotationPolicy(crt) != v1alpha1.RotationPolicyAlways {
+		return false, nil
+	}
+	return c.issuedCertificateStored(crt)
+}
+
+// issuedCertificateStored returns true if the Certificate's target secret
+// contains a certificate that has been issued for it, rather than a temporary
+// certificate. Replacing the private key in the secret without also replacing
+// this certificate would leave the secret holding a mismatched key pair.
+func (c *Controller) issuedCertificateStored(crt *v1alpha1.Certificate) (bool, error) {
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if k8sErrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	certData := secret.Data[corev1.TLSCertKey]
+	if len(certData) == 0 {
+		return false, nil
+	}
+	cert, err := pki.DecodeX509CertificateBytes(certData)
+	if err != nil {
+		return false, nil
+	}
+
+	return !isTemporaryCertificate(cert), nil
+}
+
+// ensureNextPrivateKey returns the PEM encoded and decoded private key stored
+// in the Certificate's next private key secret. If the secret does not exist,
+// or does not contain a private key matching the Certificate's spec, a new
+// private key is generated and stored, and created will be true. The caller
+// should wait for the secret to be observed before using the new key, so that
+// issuers reading the key from the secret will see the same key.
+func (c *Controller) ensureNextPrivateKey(ctx context.Context, crt *v1alpha1.Certificate) (keyPEM []byte, key crypto.Signer, created bool, err error) {
+	log := logf.FromContext(ctx)
+	name := apiutil.NextPrivateKeySecretName(crt)
+	log = logf.WithRelatedResourceName(log, name, crt.Namespace, "Secret")
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(name)
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return nil, nil, false, err
+	}
+	if secret != nil && !metav1.IsControlledBy(secret, crt) {
+		c.Recorder.Eventf(crt, corev1.EventTypeWarning, errorConfig, "Secret %q already exists and is not owned by the Certificate", name)
+		return nil, nil, false, fmt.Errorf("secret %q already exists and is not owned by certificate %q", name, crt.Name)
+	}
+
+	if secret != nil {
+		keyPEM = secret.Data[corev1.TLSPrivateKeyKey]
+		if key, err := pki.DecodePrivateKeyBytes(keyPEM); err == nil && privateKeyMatchesSpec(key, crt) {
+			return keyPEM, key, false, nil
+		}
+	}
+
+	log.Info("generating new private key for rotation")
+	key, err = pki.GeneratePrivateKeyForCertificate(crt)
+	if err != nil {
+		c.Recorder.Eventf(crt, corev1.EventTypeWarning, errorConfig, "Error generating private key: %v", err)
+		return nil, nil, false, err
+	}
+	keyPEM, err = pki.EncodePrivateKeyWithEncoding(key, crt.Spec.KeyEncoding)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	if secret == nil {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       crt.Namespace,
+				Labels:          map[string]string{v1alpha1.CertificateNameKey: crt.Name},
+				OwnerReferences: []metav1.OwnerReference{ownerRef(crt)},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{corev1.TLSPrivateKeyKey: keyPEM},
+		}
+		_, err = c.Client.CoreV1().Secrets(crt.Namespace).Create(secret)
+	} else {
+		secret = secret.DeepCopy()
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[corev1.TLSPrivateKeyKey] = keyPEM
+		_, err = c.Client.CoreV1().Secrets(crt.Namespace).Update(secret)
+	}
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	c.Recorder.Event(crt, corev1.EventTypeNormal, reasonGeneratedKey, "Generated new private key for rotation")
+
+	return keyPEM, key, true, nil
+}
+
+// cleanupNextPrivateKey deletes the Certificate's next private key secret,
+// once a certificate for the new private key has been stored in the target
+// secret.
+func (c *Controller) cleanupNextPrivateKey(crt *v1alpha1.Certificate) error {
+	name := apiutil.NextPrivateKeySecretName(crt)
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(name)
+	if k8sErrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(secret, crt) {
+		return nil
+	}
+
+	err = c.Client.CoreV1().Secrets(crt.Namespace).Delete(name, nil)
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestPrivateKeyRotationRequired(t *testing.T) {
+	now := time.Now()
+	crt := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateSecretName("output"),
+	)
+	crtAlways := gen.CertificateFrom(crt, gen.SetCertificatePrivateKeyRotationPolicy(cmapi.RotationPolicyAlways))
+	crtNever := gen.CertificateFrom(crt, gen.SetCertificatePrivateKeyRotationPolicy(cmapi.RotationPolicyNever))
+
+	pk := generatePrivateKey(t)
+	certPEM := generateSelfSignedCert(t, crt, nil, pk, now, now.Add(time.Hour))
+	tempCertPEM := generateSelfSignedCert(t, crt, big.NewInt(staticTemporarySerialNumber), pk, now, now)
+	secretWithCert := func(cert []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "output"},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       cert,
+				corev1.TLSPrivateKeyKey: pki.EncodePKCS1PrivateKey(pk),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		crt      *cmapi.Certificate
+		secret   *corev1.Secret
+		expected bool
+	}{
+		"should not rotate if no policy is set": {
+			crt:    crt,
+			secret: secretWithCert(certPEM),
+		},
+		"should not rotate if the policy is Never": {
+			crt:    crtNever,
+			secret: secretWithCert(certPEM),
+		},
+		"should rotate if the policy is Always and a certificate has been issued": {
+			crt:      crtAlways,
+			secret:   secretWithCert(certPEM),
+			expected: true,
+		},
+		"should not rotate if the secret only contains a temporary certificate": {
+			crt:    crtAlways,
+			secret: secretWithCert(tempCertPEM),
+		},
+		"should not rotate if the secret does not contain a valid certificate": {
+			crt:    crtAlways,
+			secret: secretWithCert([]byte("invalid")),
+		},
+		"should not rotate if the secret does not exist": {
+			crt: crtAlways,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{}
+			if test.secret != nil {
+				builder.KubeObjects = []runtime.Object{test.secret}
+			}
+			f := &controllerFixture{Builder: builder}
+			f.Setup(t)
+			defer f.Finish(t)
+
+			rotate, err := f.Controller.privateKeyRotationRequired(test.crt)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rotate != test.expected {
+				t.Errorf("expected rotation required to be %t but got %t", test.expected, rotate)
+			}
+		})
+	}
+}
+
+func TestEnsureNextPrivateKey(t *testing.T) {
+	crt := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificatePrivateKeyRotationPolicy(cmapi.RotationPolicyAlways),
+		func(crt *cmapi.Certificate) { crt.UID = "test-uid" },
+	)
+
+	pk := generatePrivateKey(t)
+	pkPEM := pki.EncodePKCS1PrivateKey(pk)
+	ecPK, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecPKPEM, err := pki.EncodeECPrivateKey(ecPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nextKeySecret := func(key []byte, owned bool) *corev1.Secret {
+		s := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: gen.DefaultTestNamespace,
+				Name:      "test-next-private-key",
+				Labels:    map[string]string{cmapi.CertificateNameKey: "test"},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{corev1.TLSPrivateKeyKey: key},
+		}
+		if owned {
+			s.OwnerReferences = []metav1.OwnerReference{ownerRef(crt)}
+		}
+		return s
+	}
+	checkGeneratedKey := func(secret *corev1.Secret) error {
+		if !metav1.IsControlledBy(secret, crt) {
+			return fmt.Errorf("expected secret to be owned by the certificate")
+		}
+		if secret.Labels[cmapi.CertificateNameKey] != "test" {
+			return fmt.Errorf("expected certificate name label to be set, got %v", secret.Labels)
+		}
+		key, err := pki.DecodePrivateKeyBytes(secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return err
+		}
+		if !privateKeyMatchesSpec(key, crt) {
+			return fmt.Errorf("expected generated private key to match spec")
+		}
+		return nil
+	}
+
+	tests := map[string]struct {
+		existing        *corev1.Secret
+		expectedActions []testpkg.Action
+		expectedKey     []byte
+		expectCreated   bool
+		expectErr       bool
+	}{
+		"should generate and store a new private key if the secret does not exist": {
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					gen.DefaultTestNamespace,
+					&corev1.Secret{},
+				), func(exp, act coretesting.Action) error {
+					return checkGeneratedKey(act.(coretesting.CreateAction).GetObject().(*corev1.Secret))
+				}),
+			},
+			expectCreated: true,
+		},
+		"should return the existing private key if it matches the spec": {
+			existing:    nextKeySecret(pkPEM, true),
+			expectedKey: pkPEM,
+		},
+		"should replace an existing private key that does not match the spec": {
+			existing: nextKeySecret(ecPKPEM, true),
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewUpdateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					gen.DefaultTestNamespace,
+					&corev1.Secret{},
+				), func(exp, act coretesting.Action) error {
+					return checkGeneratedKey(act.(coretesting.UpdateAction).GetObject().(*corev1.Secret))
+				}),
+			},
+			expectCreated: true,
+		},
+		"should error if the secret is not owned by the certificate": {
+			existing:  nextKeySecret(pkPEM, false),
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{ExpectedActions: test.expectedActions}
+			if test.existing != nil {
+				builder.KubeObjects = []runtime.Object{test.existing}
+			}
+			f := &controllerFixture{Builder: builder}
+			f.Setup(t)
+			defer f.Finish(t)
+
+			keyPEM, key, created, err := f.Controller.ensureNextPrivateKey(context.Background(), crt)
+			if err != nil != test.expectErr {
+				t.Fatalf("expected error to be %t but got: %v", test.expectErr, err)
+			}
+			if test.expectErr {
+				return
+			}
+			if created != test.expectCreated {
+				t.Errorf("expected created to be %t but got %t", test.expectCreated, created)
+			}
+			if key == nil {
+				t.Errorf("expected a private key to be returned")
+			}
+			if test.expectedKey != nil && !bytes.Equal(keyPEM, test.expectedKey) {
+				t.Errorf("expected the existing private key to be returned")
+			}
+		})
+	}
+}
+
+func TestCleanupNextPrivateKey(t *testing.T) {
+	crt := gen.Certificate("test",
+		gen.SetCertificateSecretName("output"),
+		func(crt *cmapi.Certificate) { crt.UID = "test-uid" },
+	)
+	nextKeySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: gen.DefaultTestNamespace,
+			Name:      "test-next-private-key",
+		},
+	}
+	ownedNextKeySecret := nextKeySecret.DeepCopy()
+	ownedNextKeySecret.OwnerReferences = []metav1.OwnerReference{ownerRef(crt)}
+
+	tests := map[string]struct {
+		existing        *corev1.Secret
+		expectedActions []testpkg.Action
+	}{
+		"should delete the next private key secret if it is owned by the certificate": {
+			existing: ownedNextKeySecret,
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					gen.DefaultTestNamespace,
+					"test-next-private-key",
+				)),
+			},
+		},
+		"should not delete a secret not owned by the certificate": {
+			existing: nextKeySecret,
+		},
+		"should do nothing if the secret does not exist": {},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{ExpectedActions: test.expectedActions}
+			if test.existing != nil {
+				builder.KubeObjects = []runtime.Object{test.existing}
+			}
+			f := &controllerFixture{Builder: builder}
+			f.Setup(t)
+			defer f.Finish(t)
+
+			if err := f.Controller.cleanupNextPrivateKey(crt); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -20,10 +20,14 @@ import (
 	"context"
 	"crypto"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"math/big"
+	"net"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -32,10 +36,12 @@ import (
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/validation"
+	"github.com/jetstack/cert-manager/pkg/feature"
 	"github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/util"
@@ -140,29 +146,47 @@ func (c *Controller) Sync(ctx context.Context, crt *v1alpha1.Certificate) (err e
 
 	if isTemporaryCertificate(cert) {
 		dbg.Info("Temporary certificate found - calling 'issue'")
-		return c.issue(ctx, i, crtCopy)
+		return c.issue(ctx, issuerObj, i, crtCopy)
 	}
 
 	if key == nil || cert == nil {
 		dbg.Info("Invoking issue function as existing certificate does not exist")
-		return c.issue(ctx, i, crtCopy)
+		return c.issue(ctx, issuerObj, i, crtCopy)
+	}
+
+	if apiutil.CertificateIssuanceRequested(crtCopy) {
+		dbg.Info("invoking issue function as re-issuance has been manually triggered")
+		// the trigger is only recorded the first time it is observed, as
+		// issuance may take several syncs to complete
+		if !apiutil.CertificateIssuanceInProgress(crtCopy) {
+			c.Recorder.Event(crtCopy, corev1.EventTypeNormal, v1alpha1.CertificateReasonManuallyTriggered, "Re-issuance of the certificate has been manually triggered")
+			markIssuanceInProgress(crtCopy)
+		}
+		return c.issue(ctx, issuerObj, i, crtCopy)
 	}
 
 	// begin checking if the TLS certificate is valid/needs a re-issue or renew
 	matches, matchErrs := c.certificateMatchesSpec(crtCopy, key, cert)
 	if !matches {
 		dbg.Info("invoking issue function due to certificate not matching spec", "diff", strings.Join(matchErrs, ", "))
-		return c.issue(ctx, i, crtCopy)
+		return c.issue(ctx, issuerObj, i, crtCopy)
 	}
 
 	// check if the certificate needs renewal
 	needsRenew := c.Context.IssuerOptions.CertificateNeedsRenew(cert, crt)
 	if needsRenew {
 		dbg.Info("invoking issue function due to certificate needing renewal")
-		return c.issue(ctx, i, crtCopy)
+		return c.issue(ctx, issuerObj, i, crtCopy)
 	}
 	// end checking if the TLS certificate is valid/needs a re-issue or renew
 
+	// if only the private key encoding, keystores, additional output formats
+	// or secretTemplate have changed, update the existing secret without
+	// re-issuing the certificate
+	if err := c.updateSecretDataIfRequired(ctx, crtCopy); err != nil {
+		return err
+	}
+
 	dbg.Info("Certificate does not need updating. Scheduling renewal.")
 	// If the Certificate is valid and up to date, we schedule a renewal in
 	// the future.
@@ -171,6 +195,105 @@ func (c *Controller) Sync(ctx context.Context, crt *v1alpha1.Certificate) (err e
 	return nil
 }
 
+// updateSecretDataIfRequired will re-write the Certificate's target secret
+// if the private key is not encoded using the key encoding specified on the
+// Certificate, if the keystores or additional output formats stored in the
+// secret do not match the Certificate's spec, or if the labels and
+// annotations on the secret do not match the Certificate's secretTemplate.
+// The existing private key, certificate and CA data are kept as-is.
+func (c *Controller) updateSecretDataIfRequired(ctx context.Context, crt *v1alpha1.Certificate) error {
+	log := logf.FromContext(ctx)
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil {
+		return err
+	}
+
+	keyPEM := secret.Data[corev1.TLSPrivateKeyKey]
+	var reasons []string
+	if !privateKeyEncodingMatchesSpec(crt, keyPEM) {
+		reasons = append(reasons, fmt.Sprintf("Private key not encoded using %q encoding", keyEncoding(crt)))
+	}
+	reasons = append(reasons, c.keystoresMatchSpec(crt, secret)...)
+	reasons = append(reasons, additionalOutputFormatsMatchSpec(crt, secret)...)
+	reasons = append(reasons, secretTemplateMatchesSpec(crt, secret)...)
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	log.Info("updating secret data as it does not match spec", "diff", strings.Join(reasons, ", "))
+	if _, err := c.updateSecret(ctx, crt, crt.Namespace, secret.Data[corev1.TLSCertKey], keyPEM, secret.Data[TLSCAKey]); err != nil {
+		c.Recorder.Event(crt, corev1.EventTypeWarning, errorSavingCertificate, messageErrorSavingCertificate+err.Error())
+		return err
+	}
+	c.Recorder.Eventf(crt, corev1.EventTypeNormal, "SecretUpdated", "Updated secret data: %s", strings.Join(reasons, ", "))
+
+	return nil
+}
+
+// privateKeyEncodingMatchesSpec returns true if the given PEM encoded private
+// key is encoded using the key encoding specified on the Certificate.
+// Ed25519 keys are always expected to be encoded in PKCS#8 format.
+func privateKeyEncodingMatchesSpec(crt *v1alpha1.Certificate, keyPEM []byte) bool {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		// an invalid private key will be handled when the certificate is
+		// next checked, so there is nothing to re-encode
+		return true
+	}
+
+	isPKCS8 := block.Type == "PRIVATE KEY"
+	wantPKCS8 := keyEncoding(crt) == v1alpha1.PKCS8 || crt.Spec.KeyAlgorithm == v1alpha1.Ed25519KeyAlgorithm
+
+	return isPKCS8 == wantPKCS8
+}
+
+// setSecretTemplateMetadata copies the labels and annotations on the
+// Certificate's secretTemplate onto the given Secret.
+func setSecretTemplateMetadata(crt *v1alpha1.Certificate, secret *corev1.Secret) {
+	if crt.Spec.SecretTemplate == nil {
+		return
+	}
+	for k, v := range crt.Spec.SecretTemplate.Annotations {
+		secret.Annotations[k] = v
+	}
+	for k, v := range crt.Spec.SecretTemplate.Labels {
+		secret.Labels[k] = v
+	}
+}
+
+// secretTemplateMatchesSpec returns a list of reasons why the labels and
+// annotations on the given Secret do not match the Certificate's
+// secretTemplate. Additional labels and annotations on the Secret are
+// ignored.
+func secretTemplateMatchesSpec(crt *v1alpha1.Certificate, secret *corev1.Secret) []string {
+	if crt.Spec.SecretTemplate == nil {
+		return nil
+	}
+	var reasons []string
+	for k, v := range crt.Spec.SecretTemplate.Annotations {
+		if existing, ok := secret.Annotations[k]; !ok || existing != v {
+			reasons = append(reasons, fmt.Sprintf("Annotation %q does not match secretTemplate", k))
+		}
+	}
+	for k, v := range crt.Spec.SecretTemplate.Labels {
+		if existing, ok := secret.Labels[k]; !ok || existing != v {
+			reasons = append(reasons, fmt.Sprintf("Label %q does not match secretTemplate", k))
+		}
+	}
+	sort.Strings(reasons)
+	return reasons
+}
+
+// keyEncoding returns the key encoding that should be used for the private
+// key of the given Certificate, defaulting to PKCS1.
+func keyEncoding(crt *v1alpha1.Certificate) v1alpha1.KeyEncoding {
+	if crt.Spec.KeyEncoding == "" {
+		return v1alpha1.PKCS1
+	}
+	return crt.Spec.KeyEncoding
+}
+
 // setCertificateStatus will update the status subresource of the certificate.
 // It will not actually submit the resource to the apiserver.
 func (c *Controller) setCertificateStatus(crt *v1alpha1.Certificate, key crypto.Signer, cert *x509.Certificate) {
@@ -181,6 +304,12 @@ func (c *Controller) setCertificateStatus(crt *v1alpha1.Certificate, key crypto.
 
 	metaNotAfter := metav1.NewTime(cert.NotAfter)
 	crt.Status.NotAfter = &metaNotAfter
+	metaNotBefore := metav1.NewTime(cert.NotBefore)
+	crt.Status.NotBefore = &metaNotBefore
+	metaRenewalTime := metav1.NewTime(c.Context.IssuerOptions.CalculateRenewalTime(cert, crt))
+	crt.Status.RenewalTime = &metaRenewalTime
+	crt.Status.SerialNumber = pki.SerialNumberString(cert)
+	crt.Status.Fingerprint = pki.SHA256Fingerprint(cert)
 
 	// Derive & set 'Ready' condition on Certificate resource
 	matches, matchErrs := c.certificateMatchesSpec(crt, key, cert)
@@ -191,8 +320,12 @@ func (c *Controller) setCertificateStatus(crt *v1alpha1.Certificate, key crypto.
 	case isTemporaryCertificate(cert):
 		reason = "TemporaryCertificate"
 		message = "Certificate issuance in progress. Temporary certificate issued."
-		// clear the NotAfter field as it is not relevant to the user
+		// clear the certificate fields as they are not relevant to the user
 		crt.Status.NotAfter = nil
+		crt.Status.NotBefore = nil
+		crt.Status.RenewalTime = nil
+		crt.Status.SerialNumber = ""
+		crt.Status.Fingerprint = ""
 	case cert.NotAfter.Before(c.clock.Now()):
 		reason = "Expired"
 		message = fmt.Sprintf("Certificate has expired on %s", cert.NotAfter.Format(time.RFC822))
@@ -225,12 +358,31 @@ func (c *Controller) certificateMatchesSpec(crt *v1alpha1.Certificate, key crypt
 		errs = append(errs, fmt.Sprintf("Certificate private key does not match certificate"))
 	}
 
+	// if the Certificate uses a provided private key, check that it has not
+	// been changed since the certificate was issued
+	if !c.providedPrivateKeyMatches(c.ctx, crt, key) {
+		errs = append(errs, "Certificate private key does not match the private key in the referenced Secret")
+	}
+
 	// validate the common name is correct
 	expectedCN := pki.CommonNameForCertificate(crt)
 	if expectedCN != cert.Subject.CommonName {
 		errs = append(errs, fmt.Sprintf("Common name on TLS certificate not up to date: %q", cert.Subject.CommonName))
 	}
 
+	// validate the rest of the subject is correct. Issuers that do not
+	// honour the request choose the subject themselves, and do not allow
+	// one to be specified.
+	honoursRequest := c.issuerHonoursRequest(crt)
+	if honoursRequest || crt.Spec.Subject != nil {
+		errs = append(errs, subjectMatchesSpec(crt.Spec.Subject, cert.Subject)...)
+	}
+
+	// validate the path length and name constraints are correct
+	if crt.Spec.IsCA {
+		errs = append(errs, caConstraintsMatchSpec(crt.Spec.MaxPathLen, crt.Spec.NameConstraints, cert)...)
+	}
+
 	// validate the dns names are correct
 	expectedDNSNames := pki.DNSNamesForCertificate(crt)
 	if !util.EqualUnsorted(cert.DNSNames, expectedDNSNames) {
@@ -242,6 +394,17 @@ func (c *Controller) certificateMatchesSpec(crt *v1alpha1.Certificate, key crypt
 		errs = append(errs, fmt.Sprintf("IP addresses on TLS certificate not up to date: %q", pki.IPAddressesToString(cert.IPAddresses)))
 	}
 
+	// validate the uri SANs are correct
+	errs = append(errs, uriSANsMatchSpec(crt, cert)...)
+
+	// validate the email SANs are correct
+	if !util.EqualUnsorted(cert.EmailAddresses, crt.Spec.EmailSANs) {
+		errs = append(errs, fmt.Sprintf("Email SANs on TLS certificate not up to date: %q", cert.EmailAddresses))
+	}
+
+	// validate the key usages are correct
+	errs = append(errs, keyUsagesMatchSpec(crt, honoursRequest, cert)...)
+
 	// get a copy of the current secret resource
 	// Note that we already know that it exists, no need to check for errors
 	// TODO: Refactor so that the secret is passed as argument?
@@ -260,6 +423,178 @@ func (c *Controller) certificateMatchesSpec(crt *v1alpha1.Certificate, key crypt
 	return len(errs) == 0, errs
 }
 
+// subjectMatchesSpec returns a list of differences between the given X.509
+// subject and the subject specified on a Certificate resource. If no subject
+// is specified, the certificate must not have any of these subject fields.
+func subjectMatchesSpec(expected *v1alpha1.X509Subject, actual pkix.Name) []string {
+	if expected == nil {
+		expected = &v1alpha1.X509Subject{}
+	}
+
+	var errs []string
+	if !util.EqualUnsorted(actual.Country, expected.Countries) {
+		errs = append(errs, fmt.Sprintf("Countries on TLS certificate not up to date: %q", actual.Country))
+	}
+	if !util.EqualUnsorted(actual.OrganizationalUnit, expected.OrganizationalUnits) {
+		errs = append(errs, fmt.Sprintf("Organizational units on TLS certificate not up to date: %q", actual.OrganizationalUnit))
+	}
+	if !util.EqualUnsorted(actual.Locality, expected.Localities) {
+		errs = append(errs, fmt.Sprintf("Localities on TLS certificate not up to date: %q", actual.Locality))
+	}
+	if !util.EqualUnsorted(actual.Province, expected.Provinces) {
+		errs = append(errs, fmt.Sprintf("Provinces on TLS certificate not up to date: %q", actual.Province))
+	}
+	if !util.EqualUnsorted(actual.StreetAddress, expected.StreetAddresses) {
+		errs = append(errs, fmt.Sprintf("Street addresses on TLS certificate not up to date: %q", actual.StreetAddress))
+	}
+	if !util.EqualUnsorted(actual.PostalCode, expected.PostalCodes) {
+		errs = append(errs, fmt.Sprintf("Postal codes on TLS certificate not up to date: %q", actual.PostalCode))
+	}
+	if actual.SerialNumber != expected.SerialNumber {
+		errs = append(errs, fmt.Sprintf("Subject serial number on TLS certificate not up to date: %q", actual.SerialNumber))
+	}
+	return errs
+}
+
+// uriSANsMatchSpec returns a list of differences between the URI SANs of the
+// given certificate and the URI SANs specified on a Certificate resource.
+// The URIs in the spec are parsed first, so that they are compared in the
+// same canonical form that they are encoded into the certificate in.
+func uriSANsMatchSpec(crt *v1alpha1.Certificate, actual *x509.Certificate) []string {
+	expected, err := pki.URIsForCertificate(crt)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	if !util.EqualUnsorted(pki.URLsToString(actual.URIs), pki.URLsToString(expected)) {
+		return []string{fmt.Sprintf("URI SANs on TLS certificate not up to date: %q", pki.URLsToString(actual.URIs))}
+	}
+	return nil
+}
+
+// issuerHonoursRequest returns true if the issuer referenced by the
+// Certificate issues certificates with exactly the subject and key usages
+// requested on the Certificate. Other issuers, such as ACME servers, choose
+// some or all of these themselves.
+func (c *Controller) issuerHonoursRequest(crt *v1alpha1.Certificate) bool {
+	issuerObj, err := c.helper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if err != nil {
+		return false
+	}
+	issuerType, err := apiutil.NameForIssuer(issuerObj)
+	if err != nil {
+		return false
+	}
+	return issuerType == apiutil.IssuerCA || issuerType == apiutil.IssuerSelfSigned
+}
+
+// keyUsagesMatchSpec returns a list of differences between the key usages
+// and extended key usages of the given certificate and the usages specified
+// on a Certificate resource. If exact is true, the certificate must have
+// exactly the expected usages. Otherwise the issuer is free to choose the
+// key usages, and the certificate only has to include every extended key
+// usage that has been explicitly requested.
+func keyUsagesMatchSpec(crt *v1alpha1.Certificate, exact bool, actual *x509.Certificate) []string {
+	ku, eku, err := pki.BuildKeyUsages(crt.Spec.Usages, crt.Spec.IsCA)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	if !exact && len(crt.Spec.Usages) == 0 {
+		return nil
+	}
+
+	var errs []string
+	if exact && actual.KeyUsage != ku {
+		errs = append(errs, fmt.Sprintf("Key usages on TLS certificate not up to date: %d", actual.KeyUsage))
+	}
+	if !hasExtKeyUsages(actual.ExtKeyUsage, eku) || (exact && len(actual.ExtKeyUsage) != len(eku)) {
+		errs = append(errs, fmt.Sprintf("Extended key usages on TLS certificate not up to date: %v", actual.ExtKeyUsage))
+	}
+	return errs
+}
+
+// hasExtKeyUsages returns true if every one of the expected extended key
+// usages is in the given list.
+func hasExtKeyUsages(usages, expected []x509.ExtKeyUsage) bool {
+	for _, e := range expected {
+		found := false
+		for _, u := range usages {
+			if u == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// caConstraintsMatchSpec returns a list of differences between the path
+// length and name constraints of the given CA certificate and those specified
+// on a Certificate resource
+func caConstraintsMatchSpec(maxPathLen *int32, nc *v1alpha1.NameConstraints, actual *x509.Certificate) []string {
+	var errs []string
+	switch {
+	case maxPathLen == nil:
+		// an unconstrained CA certificate must not have a path length
+		if actual.MaxPathLen > 0 || actual.MaxPathLenZero {
+			errs = append(errs, fmt.Sprintf("Maximum path length on TLS certificate not up to date: %d", actual.MaxPathLen))
+		}
+	case actual.MaxPathLen != int(*maxPathLen) || (*maxPathLen == 0 && !actual.MaxPathLenZero):
+		errs = append(errs, fmt.Sprintf("Maximum path length on TLS certificate not up to date: %d", actual.MaxPathLen))
+	}
+	if nc == nil {
+		// an unconstrained CA certificate must not have any name constraints
+		nc = &v1alpha1.NameConstraints{}
+	}
+	if actual.PermittedDNSDomainsCritical != nc.Critical {
+		errs = append(errs, fmt.Sprintf("Name constraints criticality on TLS certificate not up to date: %t", actual.PermittedDNSDomainsCritical))
+	}
+	permitted, excluded := nc.Permitted, nc.Excluded
+	if permitted == nil {
+		permitted = &v1alpha1.NameConstraintItem{}
+	}
+	if excluded == nil {
+		excluded = &v1alpha1.NameConstraintItem{}
+	}
+	if !util.EqualUnsorted(actual.PermittedDNSDomains, permitted.DNSDomains) || !util.EqualUnsorted(actual.ExcludedDNSDomains, excluded.DNSDomains) {
+		errs = append(errs, fmt.Sprintf("DNS name constraints on TLS certificate not up to date: permitted %q, excluded %q", actual.PermittedDNSDomains, actual.ExcludedDNSDomains))
+	}
+	if !util.EqualUnsorted(ipNetsToString(actual.PermittedIPRanges), canonicalCIDRs(permitted.IPRanges)) || !util.EqualUnsorted(ipNetsToString(actual.ExcludedIPRanges), canonicalCIDRs(excluded.IPRanges)) {
+		errs = append(errs, fmt.Sprintf("IP range name constraints on TLS certificate not up to date: permitted %q, excluded %q", ipNetsToString(actual.PermittedIPRanges), ipNetsToString(actual.ExcludedIPRanges)))
+	}
+	if !util.EqualUnsorted(actual.PermittedEmailAddresses, permitted.EmailAddresses) || !util.EqualUnsorted(actual.ExcludedEmailAddresses, excluded.EmailAddresses) {
+		errs = append(errs, fmt.Sprintf("Email name constraints on TLS certificate not up to date: permitted %q, excluded %q", actual.PermittedEmailAddresses, actual.ExcludedEmailAddresses))
+	}
+	if !util.EqualUnsorted(actual.PermittedURIDomains, permitted.URIDomains) || !util.EqualUnsorted(actual.ExcludedURIDomains, excluded.URIDomains) {
+		errs = append(errs, fmt.Sprintf("URI name constraints on TLS certificate not up to date: permitted %q, excluded %q", actual.PermittedURIDomains, actual.ExcludedURIDomains))
+	}
+	return errs
+}
+
+func ipNetsToString(ipNets []*net.IPNet) []string {
+	var out []string
+	for _, n := range ipNets {
+		out = append(out, n.String())
+	}
+	return out
+}
+
+// canonicalCIDRs converts each CIDR to the form it takes once encoded in a
+// certificate, e.g. '10.1.2.3/8' to '10.0.0.0/8'
+func canonicalCIDRs(cidrs []string) []string {
+	var out []string
+	for _, c := range cidrs {
+		if _, n, err := net.ParseCIDR(c); err == nil {
+			c = n.String()
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
 func (c *Controller) scheduleRenewal(ctx context.Context, crt *v1alpha1.Certificate) {
 	log := logf.FromContext(ctx)
 	log = log.WithValues(
@@ -329,6 +664,12 @@ func (c *Controller) updateSecret(ctx context.Context, crt *v1alpha1.Certificate
 	if err != nil {
 		return nil, fmt.Errorf("error decoding private key: %v", err)
 	}
+	// always store the private key using the encoding requested on the
+	// Certificate, regardless of how the issuer encoded it
+	key, err = pki.EncodePrivateKeyWithEncoding(privKey, crt.Spec.KeyEncoding)
+	if err != nil {
+		return nil, err
+	}
 
 	// get a copy of the current secret resource
 	secret, err := c.secretLister.Secrets(namespace).Get(crt.Spec.SecretName)
@@ -402,6 +743,10 @@ func (c *Controller) updateSecret(ctx context.Context, crt *v1alpha1.Certificate
 		c.Recorder.Event(crt, corev1.EventTypeNormal, "GenerateSelfSigned", "Generated temporary self signed certificate")
 	}
 
+	// copy across the labels and annotations from the secretTemplate before
+	// setting the cert-manager managed metadata below
+	setSecretTemplateMetadata(crt, secret)
+
 	// TODO: move metadata setting out of this method, and support
 	// retrospectively adding metadata annotations on every Sync iteration and
 	// not just when a new certificate is issued
@@ -410,6 +755,7 @@ func (c *Controller) updateSecret(ctx context.Context, crt *v1alpha1.Certificate
 	secret.Annotations[v1alpha1.CommonNameAnnotationKey] = x509Cert.Subject.CommonName
 	secret.Annotations[v1alpha1.AltNamesAnnotationKey] = strings.Join(x509Cert.DNSNames, ",")
 	secret.Annotations[v1alpha1.IPSANAnnotationKey] = strings.Join(pki.IPAddressesToString(x509Cert.IPAddresses), ",")
+	secret.Annotations[v1alpha1.URISANAnnotationKey] = strings.Join(pki.URLsToString(x509Cert.URIs), ",")
 
 	// Always set the certificate name label on the target secret
 	secret.Labels[v1alpha1.CertificateNameKey] = crt.Name
@@ -419,6 +765,13 @@ func (c *Controller) updateSecret(ctx context.Context, crt *v1alpha1.Certificate
 	secret.Data[corev1.TLSPrivateKeyKey] = key
 	secret.Data[TLSCAKey] = ca
 
+	if err := c.setKeystores(crt, secret, privKey, cert, ca); err != nil {
+		return nil, err
+	}
+	if err := setAdditionalOutputFormats(crt, secret, key, cert); err != nil {
+		return nil, err
+	}
+
 	// if it is a new resource
 	if secret.SelfLink == "" {
 		enableOwner := c.CertificateOptions.EnableOwnerRef
@@ -436,10 +789,39 @@ func (c *Controller) updateSecret(ctx context.Context, crt *v1alpha1.Certificate
 }
 
 // return an error on failure. If retrieval is succesful, the certificate data
-// and private key will be stored in the named secret
-func (c *Controller) issue(ctx context.Context, issuer issuer.Interface, crt *v1alpha1.Certificate) error {
+// and private key will be stored in the named secret.
+// If the CertificateRequestControllers feature is enabled, the certificate
+// will instead be obtained by creating a CertificateRequest resource.
+func (c *Controller) issue(ctx context.Context, issuerObj v1alpha1.GenericIssuer, issuer issuer.Interface, crt *v1alpha1.Certificate) error {
 	log := logf.FromContext(ctx)
 
+	// if the Certificate uses a provided private key, it must be stored in
+	// the target secret before issuing so that a private key is never
+	// generated for it
+	if apiutil.PrivateKeySecretRef(crt) != nil {
+		ready, err := c.ensureProvidedPrivateKey(ctx, crt)
+		if err != nil || !ready {
+			return err
+		}
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.CertificateRequestControllers) {
+		return c.requestCertificate(ctx, issuerObj, crt)
+	}
+
+	rotate, err := c.privateKeyRotationRequired(crt)
+	if err != nil {
+		return err
+	}
+	if rotate {
+		// issuers will read the new private key from the next private key
+		// secret, so we must wait for it to be observed before issuing
+		_, _, created, err := c.ensureNextPrivateKey(ctx, crt)
+		if err != nil || created {
+			return err
+		}
+	}
+
 	resp, err := issuer.Issue(ctx, crt)
 	if err != nil {
 		log.Error(err, "error issuing certificate")
@@ -459,6 +841,11 @@ func (c *Controller) issue(ctx context.Context, issuer issuer.Interface, crt *v1
 
 	if len(resp.Certificate) > 0 {
 		c.Recorder.Event(crt, corev1.EventTypeNormal, successCertificateIssued, "Certificate issued successfully")
+		markIssued(crt)
+		// the new private key is now stored in the target secret
+		if err := c.cleanupNextPrivateKey(crt); err != nil {
+			return err
+		}
 		// as we have just written a certificate, we should schedule it for renewal
 		c.scheduleRenewal(ctx, crt)
 	}
@@ -466,6 +853,39 @@ func (c *Controller) issue(ctx context.Context, issuer issuer.Interface, crt *v1
 	return nil
 }
 
+// markIssuanceInProgress sets the reason of the Certificate's Issuing
+// condition to record that a manually triggered re-issuance has been
+// observed. The message set when re-issuance was requested is kept.
+func markIssuanceInProgress(crt *v1alpha1.Certificate) {
+	var message string
+	for _, cond := range crt.Status.Conditions {
+		if cond.Type == v1alpha1.CertificateConditionIssuing {
+			message = cond.Message
+		}
+	}
+	apiutil.SetCertificateCondition(crt, v1alpha1.CertificateConditionIssuing, v1alpha1.ConditionTrue, v1alpha1.CertificateReasonInProgress, message)
+}
+
+// markIssued updates the status of the Certificate after a new certificate
+// has been issued and stored in its secret. The revision is incremented, any
+// record of failed issuance attempts is reset, and if re-issuance was
+// manually triggered the Issuing condition is set to False to record that the
+// request has been completed.
+func markIssued(crt *v1alpha1.Certificate) {
+	crt.Status.LastFailureTime = nil
+	crt.Status.FailedIssuanceAttempts = 0
+
+	revision := 1
+	if crt.Status.Revision != nil {
+		revision = *crt.Status.Revision + 1
+	}
+	crt.Status.Revision = &revision
+
+	if apiutil.CertificateIssuanceRequested(crt) {
+		apiutil.SetCertificateCondition(crt, v1alpha1.CertificateConditionIssuing, v1alpha1.ConditionFalse, v1alpha1.CertificateReasonIssued, "Certificate re-issued following a manual trigger")
+	}
+}
+
 // staticTemporarySerialNumber is a fixed serial number we check for when
 // updating the status of a certificate.
 // It is used to identify temporarily generated certificates, so that friendly
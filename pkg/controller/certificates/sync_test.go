@@ -25,7 +25,10 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"fmt"
 	"math/big"
+	"net"
+	"net/url"
 	"testing"
 	"time"
 
@@ -55,6 +58,19 @@ func generatePrivateKey(t *testing.T) *rsa.PrivateKey {
 
 var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
 
+// setCertificateStatusDetails sets the status fields describing the given
+// certificate, other than NotAfter. The test controller is not configured
+// with a default renew before duration, so the renewal time is the
+// certificate's expiry time.
+func setCertificateStatusDetails(cert *x509.Certificate) gen.CertificateModifier {
+	return func(crt *cmapi.Certificate) {
+		gen.SetCertificateNotBefore(metav1.NewTime(cert.NotBefore))(crt)
+		gen.SetCertificateRenewalTime(metav1.NewTime(cert.NotAfter))(crt)
+		gen.SetCertificateSerialNumber(pki.SerialNumberString(cert))(crt)
+		gen.SetCertificateFingerprint(pki.SHA256Fingerprint(cert))(crt)
+	}
+}
+
 func generateSelfSignedCert(t *testing.T, crt *cmapi.Certificate, sn *big.Int, key crypto.Signer, notBefore, notAfter time.Time) []byte {
 	commonName := pki.CommonNameForCertificate(crt)
 	dnsNames := pki.DNSNamesForCertificate(crt)
@@ -108,6 +124,38 @@ func TestSync(t *testing.T) {
 		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test"}),
 		gen.SetCertificateSecretName("output"),
 	)
+	exampleCertURISANs := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateURISANs("spiffe://cluster.local/ns/sandbox/sa/foo"),
+	)
+	exampleCertSubject := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateSubject(&cmapi.X509Subject{Countries: []string{"GB"}}),
+	)
+	exampleCertPKCS8 := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateKeyEncoding(cmapi.PKCS8),
+	)
+	exampleCertIssuanceRequested := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:               cmapi.CertificateConditionIssuing,
+			Status:             cmapi.ConditionTrue,
+			Reason:             cmapi.CertificateReasonManuallyTriggered,
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+	exampleCertIssuanceInProgress := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:               cmapi.CertificateConditionIssuing,
+			Status:             cmapi.ConditionTrue,
+			Reason:             cmapi.CertificateReasonInProgress,
+			Message:            "Private key compromised",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+	exampleCertSecretTemplate := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateSecretTemplate(
+			map[string]string{"example.com/backup": "true"},
+			map[string]string{"app": "web"},
+		),
+	)
 	exampleCertNotFoundCondition := gen.CertificateFrom(exampleCert,
 		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
 			Type:               cmapi.CertificateConditionReady,
@@ -136,6 +184,12 @@ func TestSync(t *testing.T) {
 		t.FailNow()
 	}
 
+	pk1PKCS8PEM, err := pki.EncodePKCS8PrivateKey(pk1)
+	if err != nil {
+		t.Errorf("Error encoding test pk1 in pkcs8 format: %v", err)
+		t.FailNow()
+	}
+
 	pk2 := generatePrivateKey(t)
 	// pk2PEM := pki.EncodePKCS1PrivateKey(pk2)
 	cert2PEM := generateSelfSignedCert(t, exampleCert, nil, pk2, nowTime, nowTime.Add(time.Hour*24))
@@ -147,6 +201,29 @@ func TestSync(t *testing.T) {
 
 	localTempCert := generateSelfSignedCert(t, exampleCert, big.NewInt(staticTemporarySerialNumber), pk1, nowTime, nowTime)
 
+	upToDateSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: gen.DefaultTestNamespace,
+			Name:      "output",
+			SelfLink:  "abc",
+			Labels: map[string]string{
+				cmapi.CertificateNameKey: "test",
+			},
+			Annotations: map[string]string{
+				"certmanager.k8s.io/alt-names":   "example.com",
+				"certmanager.k8s.io/common-name": "example.com",
+				"certmanager.k8s.io/ip-sans":     "",
+				"certmanager.k8s.io/uri-sans":    "",
+				"certmanager.k8s.io/issuer-kind": "Issuer",
+				"certmanager.k8s.io/issuer-name": "test",
+			},
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       cert1PEM,
+			corev1.TLSPrivateKeyKey: pk1PEM,
+		},
+	}
+
 	tests := map[string]controllerFixture{
 		"should update certificate with NotExists if issuer does not return a keypair": {
 			Issuer: gen.Issuer("test",
@@ -218,6 +295,7 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
 								},
@@ -289,6 +367,7 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
 								},
@@ -326,7 +405,7 @@ func TestSync(t *testing.T) {
 					testpkg.NewAction(coretesting.NewUpdateAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
 						gen.DefaultTestNamespace,
-						exampleCertNotFoundCondition,
+						gen.CertificateFrom(exampleCertNotFoundCondition, gen.SetCertificateRevision(1)),
 					)),
 					testpkg.NewAction(coretesting.NewCreateAction(
 						corev1.SchemeGroupVersion.WithResource("secrets"),
@@ -342,6 +421,7 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
 								},
@@ -395,7 +475,7 @@ func TestSync(t *testing.T) {
 					testpkg.NewAction(coretesting.NewUpdateAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
 						gen.DefaultTestNamespace,
-						exampleCertNotFoundCondition,
+						gen.CertificateFrom(exampleCertNotFoundCondition, gen.SetCertificateRevision(1)),
 					)),
 					testpkg.NewAction(coretesting.NewUpdateAction(
 						corev1.SchemeGroupVersion.WithResource("secrets"),
@@ -413,6 +493,7 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
 								},
@@ -482,6 +563,8 @@ func TestSync(t *testing.T) {
 								LastTransitionTime: &nowMetaTime,
 							}),
 							gen.SetCertificateNotAfter(metav1.NewTime(cert2.NotAfter)),
+							setCertificateStatusDetails(cert2),
+							gen.SetCertificateRevision(1),
 						),
 					)),
 					testpkg.NewAction(coretesting.NewUpdateAction(
@@ -500,6 +583,7 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
 								},
@@ -546,6 +630,7 @@ func TestSync(t *testing.T) {
 								"certmanager.k8s.io/alt-names":   "example.com",
 								"certmanager.k8s.io/common-name": "example.com",
 								"certmanager.k8s.io/ip-sans":     "",
+								"certmanager.k8s.io/uri-sans":    "",
 								"certmanager.k8s.io/issuer-kind": "Issuer",
 								"certmanager.k8s.io/issuer-name": "test",
 							},
@@ -571,12 +656,13 @@ func TestSync(t *testing.T) {
 								LastTransitionTime: &nowMetaTime,
 							}),
 							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							setCertificateStatusDetails(cert1),
 						),
 					)),
 				},
 			},
 		},
-		"should update the reason field with temporary self signed cert text": {
+		"should re-issue an up to date certificate if re-issuance has been manually triggered": {
 			Issuer: gen.Issuer("test",
 				gen.AddIssuerCondition(cmapi.IssuerCondition{
 					Type:   cmapi.IssuerConditionReady,
@@ -584,18 +670,15 @@ func TestSync(t *testing.T) {
 				}),
 				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
 			),
-			Certificate: *exampleCert,
+			Certificate: *exampleCertIssuanceRequested,
 			IssuerImpl: &fake.Issuer{
 				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
 					return &issuer.IssueResponse{
-						PrivateKey: pk1PEM,
+						PrivateKey:  pk1PEM,
+						Certificate: cert1PEM,
 					}, nil
 				},
 			},
-			// set this to something other than localTempCert, so that we can
-			// assert that the controller doesn't enter in a loop updating the
-			// Secret resource with a newly generated certificate
-			StaticTemporaryCert: cert1PEM,
 			Builder: &testpkg.Builder{
 				KubeObjects: []runtime.Object{
 					&corev1.Secret{
@@ -604,14 +687,20 @@ func TestSync(t *testing.T) {
 							Name:      "output",
 							SelfLink:  "abc",
 							Labels: map[string]string{
-								cmapi.CertificateNameKey: "nottest",
+								cmapi.CertificateNameKey: "test",
 							},
 							Annotations: map[string]string{
-								"testannotation": "true",
+								"testannotation":                 "true",
+								"certmanager.k8s.io/alt-names":   "example.com",
+								"certmanager.k8s.io/common-name": "example.com",
+								"certmanager.k8s.io/ip-sans":     "",
+								"certmanager.k8s.io/uri-sans":    "",
+								"certmanager.k8s.io/issuer-kind": "Issuer",
+								"certmanager.k8s.io/issuer-name": "test",
 							},
 						},
 						Data: map[string][]byte{
-							corev1.TLSCertKey:       localTempCert,
+							corev1.TLSCertKey:       cert1PEM,
 							corev1.TLSPrivateKeyKey: pk1PEM,
 							TLSCAKey:                nil,
 						},
@@ -635,12 +724,13 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
 								},
 							},
 							Data: map[string][]byte{
-								corev1.TLSCertKey:       localTempCert,
+								corev1.TLSCertKey:       cert1PEM,
 								corev1.TLSPrivateKeyKey: pk1PEM,
 								TLSCAKey:                nil,
 							},
@@ -649,12 +739,30 @@ func TestSync(t *testing.T) {
 					testpkg.NewAction(coretesting.NewUpdateAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
 						gen.DefaultTestNamespace,
-						exampleCertTemporaryCondition,
+						gen.CertificateFrom(exampleCertIssuanceRequested,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionTrue,
+								Reason:             "Ready",
+								Message:            "Certificate is up to date and has not expired",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							setCertificateStatusDetails(cert1),
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionIssuing,
+								Status:             cmapi.ConditionFalse,
+								Reason:             cmapi.CertificateReasonIssued,
+								Message:            "Certificate re-issued following a manual trigger",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateRevision(1),
+						),
 					)),
 				},
 			},
 		},
-		"should mark certificate with wrong issuer name as DoesNotMatch": {
+		"should mark manually triggered re-issuance as in progress while waiting for the issuer": {
 			Issuer: gen.Issuer("test",
 				gen.AddIssuerCondition(cmapi.IssuerCondition{
 					Type:   cmapi.IssuerConditionReady,
@@ -662,13 +770,90 @@ func TestSync(t *testing.T) {
 				}),
 				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
 			),
-			Certificate: *exampleCert,
+			Certificate: *gen.CertificateFrom(exampleCertIssuanceRequested,
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+					Type:               cmapi.CertificateConditionIssuing,
+					Status:             cmapi.ConditionTrue,
+					Reason:             cmapi.CertificateReasonManuallyTriggered,
+					Message:            "Private key compromised",
+					LastTransitionTime: &nowMetaTime,
+				}),
+			),
 			IssuerImpl: &fake.Issuer{
 				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
-					return &issuer.IssueResponse{
-						PrivateKey:  pk1PEM,
-						Certificate: cert1PEM,
-					}, nil
+					return nil, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{upToDateSecret},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertIssuanceInProgress,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionTrue,
+								Reason:             "Ready",
+								Message:            "Certificate is up to date and has not expired",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							setCertificateStatusDetails(cert1),
+						),
+					)),
+				},
+			},
+		},
+		"should not update the Issuing condition while manually triggered re-issuance is in progress": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCertIssuanceInProgress,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return nil, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{upToDateSecret},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertIssuanceInProgress,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionTrue,
+								Reason:             "Ready",
+								Message:            "Certificate is up to date and has not expired",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							setCertificateStatusDetails(cert1),
+						),
+					)),
+				},
+			},
+		},
+		"should re-encode the private key of an up to date certificate if the key encoding has changed": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCertPKCS8,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return nil, fmt.Errorf("unexpected call to issue")
 				},
 			},
 			Builder: &testpkg.Builder{
@@ -682,9 +867,12 @@ func TestSync(t *testing.T) {
 								cmapi.CertificateNameKey: "test",
 							},
 							Annotations: map[string]string{
-								"testannotation":                 "true",
+								"certmanager.k8s.io/alt-names":   "example.com",
+								"certmanager.k8s.io/common-name": "example.com",
+								"certmanager.k8s.io/ip-sans":     "",
+								"certmanager.k8s.io/uri-sans":    "",
 								"certmanager.k8s.io/issuer-kind": "Issuer",
-								"certmanager.k8s.io/issuer-name": "not-test",
+								"certmanager.k8s.io/issuer-name": "test",
 							},
 						},
 						Data: map[string][]byte{
@@ -696,20 +884,93 @@ func TestSync(t *testing.T) {
 				},
 				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
 				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"certmanager.k8s.io/alt-names":   "example.com",
+									"certmanager.k8s.io/common-name": "example.com",
+									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/issuer-kind": "Issuer",
+									"certmanager.k8s.io/issuer-name": "test",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       cert1PEM,
+								corev1.TLSPrivateKeyKey: pk1PKCS8PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
 						gen.DefaultTestNamespace,
-						gen.CertificateFrom(exampleCert,
+						gen.CertificateFrom(exampleCertPKCS8,
 							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
 								Type:               cmapi.CertificateConditionReady,
-								Status:             cmapi.ConditionFalse,
-								Reason:             "DoesNotMatch",
-								Message:            "Issuer of the certificate is not up to date: \"not-test\"",
+								Status:             cmapi.ConditionTrue,
+								Reason:             "Ready",
+								Message:            "Certificate is up to date and has not expired",
 								LastTransitionTime: &nowMetaTime,
 							}),
 							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							setCertificateStatusDetails(cert1),
 						),
 					)),
+				},
+			},
+		},
+		"should copy the secretTemplate labels and annotations to the secret of an up to date certificate": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCertSecretTemplate,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return nil, fmt.Errorf("unexpected call to issue")
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							SelfLink:  "abc",
+							Labels: map[string]string{
+								cmapi.CertificateNameKey: "test",
+							},
+							Annotations: map[string]string{
+								"certmanager.k8s.io/alt-names":   "example.com",
+								"certmanager.k8s.io/common-name": "example.com",
+								"certmanager.k8s.io/ip-sans":     "",
+								"certmanager.k8s.io/uri-sans":    "",
+								"certmanager.k8s.io/issuer-kind": "Issuer",
+								"certmanager.k8s.io/issuer-name": "test",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       cert1PEM,
+							corev1.TLSPrivateKeyKey: pk1PEM,
+							TLSCAKey:                nil,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
 					testpkg.NewAction(coretesting.NewUpdateAction(
 						corev1.SchemeGroupVersion.WithResource("secrets"),
 						gen.DefaultTestNamespace,
@@ -720,14 +981,16 @@ func TestSync(t *testing.T) {
 								SelfLink:  "abc",
 								Labels: map[string]string{
 									cmapi.CertificateNameKey: "test",
+									"app":                    "web",
 								},
 								Annotations: map[string]string{
-									"testannotation":                 "true",
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
+									"example.com/backup":             "true",
 								},
 							},
 							Data: map[string][]byte{
@@ -737,39 +1000,400 @@ func TestSync(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertSecretTemplate,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionTrue,
+								Reason:             "Ready",
+								Message:            "Certificate is up to date and has not expired",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							setCertificateStatusDetails(cert1),
+						),
+					)),
 				},
 			},
 		},
-		//"should add annotations to already existing secret resource": {
-		//	Issuer: gen.Issuer("test",
-		//		gen.AddIssuerCondition(cmapi.IssuerCondition{
-		//			Type:   cmapi.IssuerConditionReady,
-		//			Status: cmapi.ConditionTrue,
-		//		}),
-		//		gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
-		//	),
-		//	Certificate: *gen.CertificateFrom(exampleCert,
-		//		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
-		//			Type:               cmapi.CertificateConditionReady,
-		//			Status:             cmapi.ConditionTrue,
-		//			Reason:             "Ready",
-		//			Message:            "Certificate is up to date and has not expired",
-		//			LastTransitionTime: nowMetaTime,
-		//		}),
-		//		gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
-		//	),
-		//	IssuerImpl: &fake.Issuer{
-		//		FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
-		//			return &issuer.IssueResponse{
-		//				PrivateKey:  pk1PEM,
-		//				Certificate: cert1PEM,
-		//			}, nil
-		//		},
-		//	},
-		//	Builder: &testpkg.Builder{
-		//		KubeObjects: []runtime.Object{
-		//			&corev1.Secret{
-		//				ObjectMeta: metav1.ObjectMeta{
+		"should update the reason field with temporary self signed cert text": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCert,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						PrivateKey: pk1PEM,
+					}, nil
+				},
+			},
+			// set this to something other than localTempCert, so that we can
+			// assert that the controller doesn't enter in a loop updating the
+			// Secret resource with a newly generated certificate
+			StaticTemporaryCert: cert1PEM,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							SelfLink:  "abc",
+							Labels: map[string]string{
+								cmapi.CertificateNameKey: "nottest",
+							},
+							Annotations: map[string]string{
+								"testannotation": "true",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       localTempCert,
+							corev1.TLSPrivateKeyKey: pk1PEM,
+							TLSCAKey:                nil,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"testannotation":                 "true",
+									"certmanager.k8s.io/alt-names":   "example.com",
+									"certmanager.k8s.io/common-name": "example.com",
+									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/issuer-kind": "Issuer",
+									"certmanager.k8s.io/issuer-name": "test",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       localTempCert,
+								corev1.TLSPrivateKeyKey: pk1PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						exampleCertTemporaryCondition,
+					)),
+				},
+			},
+		},
+		"should mark certificate with wrong issuer name as DoesNotMatch": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCert,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						PrivateKey:  pk1PEM,
+						Certificate: cert1PEM,
+					}, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							SelfLink:  "abc",
+							Labels: map[string]string{
+								cmapi.CertificateNameKey: "test",
+							},
+							Annotations: map[string]string{
+								"testannotation":                 "true",
+								"certmanager.k8s.io/issuer-kind": "Issuer",
+								"certmanager.k8s.io/issuer-name": "not-test",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       cert1PEM,
+							corev1.TLSPrivateKeyKey: pk1PEM,
+							TLSCAKey:                nil,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "DoesNotMatch",
+								Message:            "Issuer of the certificate is not up to date: \"not-test\"",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							setCertificateStatusDetails(cert1),
+							gen.SetCertificateRevision(1),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"testannotation":                 "true",
+									"certmanager.k8s.io/alt-names":   "example.com",
+									"certmanager.k8s.io/common-name": "example.com",
+									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/issuer-kind": "Issuer",
+									"certmanager.k8s.io/issuer-name": "test",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       cert1PEM,
+								corev1.TLSPrivateKeyKey: pk1PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
+				},
+			},
+		},
+		"should mark certificate with out of date uri SANs as DoesNotMatch": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCertURISANs,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						PrivateKey:  pk1PEM,
+						Certificate: cert1PEM,
+					}, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							SelfLink:  "abc",
+							Labels: map[string]string{
+								cmapi.CertificateNameKey: "test",
+							},
+							Annotations: map[string]string{
+								"testannotation":                 "true",
+								"certmanager.k8s.io/issuer-kind": "Issuer",
+								"certmanager.k8s.io/issuer-name": "test",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       cert1PEM,
+							corev1.TLSPrivateKeyKey: pk1PEM,
+							TLSCAKey:                nil,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertURISANs,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "DoesNotMatch",
+								Message:            "URI SANs on TLS certificate not up to date: []",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							setCertificateStatusDetails(cert1),
+							gen.SetCertificateRevision(1),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"testannotation":                 "true",
+									"certmanager.k8s.io/alt-names":   "example.com",
+									"certmanager.k8s.io/common-name": "example.com",
+									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/issuer-kind": "Issuer",
+									"certmanager.k8s.io/issuer-name": "test",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       cert1PEM,
+								corev1.TLSPrivateKeyKey: pk1PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
+				},
+			},
+		},
+		"should mark certificate with out of date subject as DoesNotMatch": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCertSubject,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						PrivateKey:  pk1PEM,
+						Certificate: cert1PEM,
+					}, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							SelfLink:  "abc",
+							Labels: map[string]string{
+								cmapi.CertificateNameKey: "test",
+							},
+							Annotations: map[string]string{
+								"testannotation":                 "true",
+								"certmanager.k8s.io/issuer-kind": "Issuer",
+								"certmanager.k8s.io/issuer-name": "test",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       cert1PEM,
+							corev1.TLSPrivateKeyKey: pk1PEM,
+							TLSCAKey:                nil,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertSubject,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "DoesNotMatch",
+								Message:            "Countries on TLS certificate not up to date: []",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							setCertificateStatusDetails(cert1),
+							gen.SetCertificateRevision(1),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"testannotation":                 "true",
+									"certmanager.k8s.io/alt-names":   "example.com",
+									"certmanager.k8s.io/common-name": "example.com",
+									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/issuer-kind": "Issuer",
+									"certmanager.k8s.io/issuer-name": "test",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       cert1PEM,
+								corev1.TLSPrivateKeyKey: pk1PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
+				},
+			},
+		},
+		//"should add annotations to already existing secret resource": {
+		//	Issuer: gen.Issuer("test",
+		//		gen.AddIssuerCondition(cmapi.IssuerCondition{
+		//			Type:   cmapi.IssuerConditionReady,
+		//			Status: cmapi.ConditionTrue,
+		//		}),
+		//		gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+		//	),
+		//	Certificate: *gen.CertificateFrom(exampleCert,
+		//		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+		//			Type:               cmapi.CertificateConditionReady,
+		//			Status:             cmapi.ConditionTrue,
+		//			Reason:             "Ready",
+		//			Message:            "Certificate is up to date and has not expired",
+		//			LastTransitionTime: nowMetaTime,
+		//		}),
+		//		gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+		//	),
+		//	IssuerImpl: &fake.Issuer{
+		//		FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+		//			return &issuer.IssueResponse{
+		//				PrivateKey:  pk1PEM,
+		//				Certificate: cert1PEM,
+		//			}, nil
+		//		},
+		//	},
+		//	Builder: &testpkg.Builder{
+		//		KubeObjects: []runtime.Object{
+		//			&corev1.Secret{
+		//				ObjectMeta: metav1.ObjectMeta{
 		//					Namespace: gen.DefaultTestNamespace,
 		//					Name:      "output",
 		//					SelfLink:  "abc",
@@ -843,3 +1467,227 @@ func TestSync(t *testing.T) {
 		})
 	}
 }
+
+func TestCAConstraintsMatchSpec(t *testing.T) {
+	zero, one := int32(0), int32(1)
+	_, ipNet, _ := net.ParseCIDR("10.0.0.0/8")
+	cert := &x509.Certificate{
+		IsCA:                        true,
+		MaxPathLen:                  0,
+		MaxPathLenZero:              true,
+		PermittedDNSDomainsCritical: true,
+		PermittedDNSDomains:         []string{"team-a.example.com"},
+		PermittedIPRanges:           []*net.IPNet{ipNet},
+	}
+
+	upToDate := &cmapi.NameConstraints{
+		Critical: true,
+		Permitted: &cmapi.NameConstraintItem{
+			DNSDomains: []string{"team-a.example.com"},
+			IPRanges:   []string{"10.1.2.3/8"},
+		},
+	}
+	unconstrained := &x509.Certificate{
+		IsCA:       true,
+		MaxPathLen: -1,
+	}
+
+	tests := map[string]struct {
+		cert         *x509.Certificate
+		maxPathLen   *int32
+		nc           *cmapi.NameConstraints
+		expectedErrs int
+	}{
+		"matches when no constraints are specified": {
+			cert: unconstrained,
+		},
+		"constraints removed from spec": {
+			expectedErrs: 4,
+		},
+		"constraints added to spec": {
+			cert:       unconstrained,
+			maxPathLen: &zero,
+			nc: &cmapi.NameConstraints{
+				Permitted: &cmapi.NameConstraintItem{
+					DNSDomains: []string{"team-a.example.com"},
+				},
+			},
+			expectedErrs: 2,
+		},
+		"matches with up to date constraints": {
+			maxPathLen: &zero,
+			nc:         upToDate,
+		},
+		"max path length not up to date": {
+			maxPathLen:   &one,
+			nc:           upToDate,
+			expectedErrs: 1,
+		},
+		"name constraints not up to date": {
+			maxPathLen: &zero,
+			nc: &cmapi.NameConstraints{
+				Permitted: &cmapi.NameConstraintItem{
+					DNSDomains: []string{"team-b.example.com"},
+				},
+				Excluded: &cmapi.NameConstraintItem{
+					EmailAddresses: []string{"example.com"},
+				},
+			},
+			expectedErrs: 4,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := test.cert
+			if actual == nil {
+				actual = cert
+			}
+			errs := caConstraintsMatchSpec(test.maxPathLen, test.nc, actual)
+			if len(errs) != test.expectedErrs {
+				t.Errorf("expected %d errors, got: %v", test.expectedErrs, errs)
+			}
+		})
+	}
+}
+
+func TestSubjectMatchesSpec(t *testing.T) {
+	subject := pkix.Name{
+		CommonName:         "example.com",
+		Country:            []string{"GB"},
+		OrganizationalUnit: []string{"Engineering"},
+	}
+
+	tests := map[string]struct {
+		subject      pkix.Name
+		expected     *cmapi.X509Subject
+		expectedErrs int
+	}{
+		"matches when no subject is specified": {
+			subject: pkix.Name{CommonName: "example.com"},
+		},
+		"subject removed from spec": {
+			subject:      subject,
+			expectedErrs: 2,
+		},
+		"subject added to spec": {
+			subject: pkix.Name{CommonName: "example.com"},
+			expected: &cmapi.X509Subject{
+				Countries: []string{"GB"},
+			},
+			expectedErrs: 1,
+		},
+		"matches with up to date subject": {
+			subject: subject,
+			expected: &cmapi.X509Subject{
+				Countries:           []string{"GB"},
+				OrganizationalUnits: []string{"Engineering"},
+			},
+		},
+		"subject not up to date": {
+			subject: subject,
+			expected: &cmapi.X509Subject{
+				Countries:    []string{"GB"},
+				SerialNumber: "1234",
+			},
+			expectedErrs: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs := subjectMatchesSpec(test.expected, test.subject)
+			if len(errs) != test.expectedErrs {
+				t.Errorf("expected %d errors, got: %v", test.expectedErrs, errs)
+			}
+		})
+	}
+}
+
+func TestURISANsMatchSpec(t *testing.T) {
+	uri, _ := url.Parse("spiffe://cluster.local/ns/sandbox/sa/foo")
+	cert := &x509.Certificate{URIs: []*url.URL{uri}}
+
+	tests := map[string]struct {
+		uriSANs      []string
+		expectedErrs int
+	}{
+		"matches with up to date uri SANs": {
+			uriSANs: []string{"spiffe://cluster.local/ns/sandbox/sa/foo"},
+		},
+		"matches with non-canonical uri SANs": {
+			uriSANs: []string{"SPIFFE://cluster.local/ns/sandbox/sa/foo"},
+		},
+		"uri SANs not up to date": {
+			uriSANs:      []string{"spiffe://cluster.local/ns/sandbox/sa/bar"},
+			expectedErrs: 1,
+		},
+		"uri SANs removed from spec": {
+			expectedErrs: 1,
+		},
+		"invalid uri SAN": {
+			uriSANs:      []string{"spiffe://cluster.local/%zz"},
+			expectedErrs: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := gen.Certificate("test", gen.SetCertificateURISANs(test.uriSANs...))
+			errs := uriSANsMatchSpec(crt, cert)
+			if len(errs) != test.expectedErrs {
+				t.Errorf("expected %d errors, got: %v", test.expectedErrs, errs)
+			}
+		})
+	}
+}
+
+func TestKeyUsagesMatchSpec(t *testing.T) {
+	cert := &x509.Certificate{
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	tests := map[string]struct {
+		usages       []cmapi.KeyUsage
+		exact        bool
+		expectedErrs int
+	}{
+		"matches with up to date usages": {
+			usages: []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageClientAuth, cmapi.UsageServerAuth},
+			exact:  true,
+		},
+		"key usages not up to date": {
+			usages:       []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageClientAuth, cmapi.UsageServerAuth},
+			exact:        true,
+			expectedErrs: 1,
+		},
+		"extended key usage removed from spec": {
+			usages:       []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageClientAuth},
+			exact:        true,
+			expectedErrs: 1,
+		},
+		"default usages not up to date": {
+			exact:        true,
+			expectedErrs: 2,
+		},
+		"issuer chooses usages when none are specified": {},
+		"issuer adds extended key usages": {
+			usages: []cmapi.KeyUsage{cmapi.UsageKeyEncipherment, cmapi.UsageServerAuth},
+		},
+		"issuer omits requested extended key usage": {
+			usages:       []cmapi.KeyUsage{cmapi.UsageCodeSigning},
+			expectedErrs: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := gen.Certificate("test", gen.SetCertificateKeyUsages(test.usages...))
+			errs := keyUsagesMatchSpec(crt, test.exact, cert)
+			if len(errs) != test.expectedErrs {
+				t.Errorf("expected %d errors, got: %v", test.expectedErrs, errs)
+			}
+		})
+	}
+}
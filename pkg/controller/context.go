@@ -86,6 +86,17 @@ type IssuerOptions struct {
 	// Once a certificate is within this duration until expiry, a new Certificate
 	// will be attempted to be issued.
 	RenewBeforeExpiryDuration time.Duration
+
+	// RenewBeforeExpiryPercentage is the default 'renew before expiry' time
+	// for Certificates, as a percentage of the certificate's total duration.
+	// If non-zero, it takes precedence over RenewBeforeExpiryDuration.
+	RenewBeforeExpiryPercentage int32
+
+	// RenewalJitterPercentage is the maximum amount of time, as a percentage of
+	// a certificate's total duration, by which renewal of a Certificate will be
+	// brought forward. The amount is chosen pseudo-randomly per certificate so
+	// that certificates issued at the same time are not all renewed at once.
+	RenewalJitterPercentage int32
 }
 
 type ACMEOptions struct {
@@ -19,6 +19,7 @@ package controller
 import (
 	"crypto/x509"
 	"fmt"
+	"hash/fnv"
 	"time"
 
 	"k8s.io/klog"
@@ -54,6 +55,12 @@ var now = time.Now
 // CalculateDurationUntilRenew calculates how long cert-manager should wait to
 // until attempting to renew this certificate resource.
 func (o IssuerOptions) CalculateDurationUntilRenew(cert *x509.Certificate, crt *cmapi.Certificate) time.Duration {
+	return o.CalculateRenewalTime(cert, crt).Sub(now())
+}
+
+// CalculateRenewalTime calculates the time at which cert-manager should
+// attempt to renew this certificate resource.
+func (o IssuerOptions) CalculateRenewalTime(cert *x509.Certificate, crt *cmapi.Certificate) time.Time {
 	messageCertificateDuration := "Certificate received from server has a validity duration of %s. The requested certificate validity duration was %s"
 	messageScheduleModified := "Certificate renewal duration was changed to fit inside the received certificate validity duration from issuer."
 
@@ -69,24 +76,57 @@ func (o IssuerOptions) CalculateDurationUntilRenew(cert *x509.Certificate, crt *
 	// renew is the duration before the certificate expiration that cert-manager
 	// will start to try renewing the certificate.
 	renewBefore := o.RenewBeforeExpiryDuration
-	if crt.Spec.RenewBefore != nil {
+	if o.RenewBeforeExpiryPercentage > 0 {
+		renewBefore = percentageOf(certDuration, o.RenewBeforeExpiryPercentage)
+	}
+	switch {
+	case crt.Spec.RenewBefore != nil:
 		renewBefore = crt.Spec.RenewBefore.Duration
+	case crt.Spec.RenewBeforePercentage != nil:
+		renewBefore = percentageOf(certDuration, *crt.Spec.RenewBeforePercentage)
 	}
 
 	// Verify that the renewBefore duration is inside the certificate validity duration.
 	// If not we notify with an event that we will renew the certificate
 	// before (certificate duration / 3) of its expiration duration.
-	if renewBefore > certDuration {
+	if renewBefore >= certDuration {
 		klog.Info(messageScheduleModified)
 		// TODO Use the message as the reason in a 'renewal status' condition
 		// We will renew 1/3 before the expiration date.
 		renewBefore = certDuration / 3
 	}
 
-	// calculate the amount of time until expiry
-	durationUntilExpiry := cert.NotAfter.Sub(now())
-	// calculate how long until we should start attempting to renew the certificate
-	renewIn := durationUntilExpiry - renewBefore
+	// bring the renewal time forward by up to RenewalJitterPercentage of the
+	// certificate duration. The jitter never moves the renewal time to before
+	// the first third of the certificate's lifetime has passed, and never
+	// moves it later than the configured renewal time.
+	jittered := renewBefore + renewalJitter(cert, percentageOf(certDuration, o.RenewalJitterPercentage))
+	if maxRenewBefore := certDuration - certDuration/3; jittered > maxRenewBefore {
+		jittered = maxRenewBefore
+	}
+	if jittered > renewBefore {
+		renewBefore = jittered
+	}
+
+	// calculate when we should start attempting to renew the certificate
+	return cert.NotAfter.Add(-renewBefore)
+}
 
-	return renewIn
+// percentageOf returns the given percentage of the duration d.
+func percentageOf(d time.Duration, percentage int32) time.Duration {
+	return d / 100 * time.Duration(percentage)
+}
+
+// renewalJitter returns a duration in the range [0, max) that is derived from
+// the contents of the given certificate. The jitter is deterministic so that
+// every sync of a Certificate calculates the same renewal time for the same
+// certificate, whilst still spreading out the renewal of certificates that
+// were issued together.
+func renewalJitter(cert *x509.Certificate, max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write(cert.Raw)
+	return time.Duration(h.Sum64() % uint64(max))
 }
@@ -104,3 +104,139 @@ func TestCalculateDurationUntilRenew(t *testing.T) {
 		}
 	}
 }
+
+func TestCalculateDurationUntilRenewPercentage(t *testing.T) {
+	currentTime := time.Now()
+	now = func() time.Time { return currentTime }
+	defer func() { now = time.Now }()
+	percentage := func(i int32) *int32 { return &i }
+	tests := []struct {
+		desc                  string
+		opts                  IssuerOptions
+		duration              time.Duration
+		renewBefore           *metav1.Duration
+		renewBeforePercentage *int32
+		expectedExpiry        time.Duration
+	}{
+		{
+			desc:           "default percentage applies to short lived certificates",
+			opts:           IssuerOptions{RenewBeforeExpiryDuration: v1alpha1.DefaultRenewBefore, RenewBeforeExpiryPercentage: 25},
+			duration:       time.Hour * 24,
+			expectedExpiry: time.Hour * 18,
+		},
+		{
+			desc:           "default percentage applies to long lived certificates",
+			opts:           IssuerOptions{RenewBeforeExpiryDuration: v1alpha1.DefaultRenewBefore, RenewBeforeExpiryPercentage: 25},
+			duration:       time.Hour * 24 * 100,
+			expectedExpiry: time.Hour * 24 * 75,
+		},
+		{
+			desc:                  "certificate percentage takes precedence over the defaults",
+			opts:                  IssuerOptions{RenewBeforeExpiryDuration: v1alpha1.DefaultRenewBefore, RenewBeforeExpiryPercentage: 25},
+			duration:              time.Hour * 24,
+			renewBeforePercentage: percentage(50),
+			expectedExpiry:        time.Hour * 12,
+		},
+		{
+			desc:           "certificate renewBefore takes precedence over the default percentage",
+			opts:           IssuerOptions{RenewBeforeExpiryDuration: v1alpha1.DefaultRenewBefore, RenewBeforeExpiryPercentage: 25},
+			duration:       time.Hour * 24,
+			renewBefore:    &metav1.Duration{time.Hour},
+			expectedExpiry: time.Hour * 23,
+		},
+	}
+	for k, v := range tests {
+		cert := &v1alpha1.Certificate{
+			Spec: v1alpha1.CertificateSpec{
+				RenewBefore:           v.renewBefore,
+				RenewBeforePercentage: v.renewBeforePercentage,
+			},
+		}
+		x509Cert := &x509.Certificate{NotBefore: now(), NotAfter: now().Add(v.duration)}
+		duration := v.opts.CalculateDurationUntilRenew(x509Cert, cert)
+		if duration != v.expectedExpiry {
+			t.Errorf("test # %d - %s: got %v, expected %v", k, v.desc, duration, v.expectedExpiry)
+		}
+	}
+}
+
+func TestCalculateDurationUntilRenewJitter(t *testing.T) {
+	c := IssuerOptions{
+		RenewBeforeExpiryPercentage: 33,
+		RenewalJitterPercentage:     10,
+	}
+	currentTime := time.Now()
+	now = func() time.Time { return currentTime }
+	defer func() { now = time.Now }()
+
+	certDuration := time.Hour * 24 * 90
+	latest := certDuration - certDuration/100*33
+	earliest := latest - certDuration/100*10
+	crt := &v1alpha1.Certificate{}
+
+	seen := map[time.Duration]bool{}
+	for i := 0; i < 20; i++ {
+		x509Cert := &x509.Certificate{
+			Raw:       []byte{byte(i)},
+			NotBefore: now(),
+			NotAfter:  now().Add(certDuration),
+		}
+		duration := c.CalculateDurationUntilRenew(x509Cert, crt)
+		if duration > latest || duration <= earliest {
+			t.Errorf("certificate %d: expected renewal in (%v, %v] but got %v", i, earliest, latest, duration)
+		}
+		if again := c.CalculateDurationUntilRenew(x509Cert, crt); again != duration {
+			t.Errorf("certificate %d: expected jitter to be stable but got %v and %v", i, duration, again)
+		}
+		seen[duration] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("expected renewal times to be spread out but all were %v", seen)
+	}
+}
+
+func TestCalculateDurationUntilRenewJitterBounds(t *testing.T) {
+	currentTime := time.Now()
+	now = func() time.Time { return currentTime }
+	defer func() { now = time.Now }()
+	percentage := func(i int32) *int32 { return &i }
+
+	certDuration := time.Hour * 24 * 90
+	tests := []struct {
+		desc                  string
+		opts                  IssuerOptions
+		renewBeforePercentage *int32
+		earliest, latest      time.Duration
+	}{
+		{
+			desc:                  "jitter does not move renewal before the first third of the lifetime",
+			opts:                  IssuerOptions{RenewalJitterPercentage: 50},
+			renewBeforePercentage: percentage(30),
+			earliest:              certDuration / 3,
+			latest:                certDuration - certDuration/100*30,
+		},
+		{
+			desc:                  "jitter does not bring a late renewal forward to the start of the lifetime",
+			opts:                  IssuerOptions{RenewalJitterPercentage: 20},
+			renewBeforePercentage: percentage(90),
+			earliest:              certDuration - certDuration/100*90,
+			latest:                certDuration - certDuration/100*90,
+		},
+	}
+	for k, v := range tests {
+		crt := &v1alpha1.Certificate{
+			Spec: v1alpha1.CertificateSpec{RenewBeforePercentage: v.renewBeforePercentage},
+		}
+		for i := 0; i < 20; i++ {
+			x509Cert := &x509.Certificate{
+				Raw:       []byte{byte(i)},
+				NotBefore: now(),
+				NotAfter:  now().Add(certDuration),
+			}
+			duration := v.opts.CalculateDurationUntilRenew(x509Cert, crt)
+			if duration < v.earliest || duration > v.latest {
+				t.Errorf("test # %d - %s: certificate %d: expected renewal in [%v, %v] but got %v", k, v.desc, i, v.earliest, v.latest, duration)
+			}
+		}
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package crlserver serves the certificate revocation lists generated for CA
+// issuers over HTTP.
+package crlserver
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1alpha1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	crlServerShutdownTimeout = 5 * time.Second
+	crlServerReadTimeout     = 8 * time.Second
+	crlServerWriteTimeout    = 8 * time.Second
+	crlServerMaxHeaderBytes  = 1 << 20 // 1 MiB
+
+	// contentTypeCRL is the media type of a DER encoded CRL, as defined in
+	// RFC 2585.
+	contentTypeCRL = "application/pkix-crl"
+)
+
+// Server serves the certificate revocation lists of CA Issuers at
+// /crl/<namespace>/<name>, and of CA ClusterIssuers at /crl/<name>.
+type Server struct {
+	ctx context.Context
+	http.Server
+
+	issuerLister        cmlisters.IssuerLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	secretLister        corelisters.SecretLister
+
+	// clusterResourceNamespace is the namespace that the CRL Secrets of
+	// ClusterIssuers are stored in
+	clusterResourceNamespace string
+}
+
+// New returns a new CRL server listening on the given address.
+// clusterIssuerLister may be nil, in which case requests for the CRLs of
+// ClusterIssuers will not be served.
+func New(ctx context.Context, addr string, issuerLister cmlisters.IssuerLister, clusterIssuerLister cmlisters.ClusterIssuerLister, secretLister corelisters.SecretLister, clusterResourceNamespace string) *Server {
+	router := mux.NewRouter()
+
+	s := &Server{
+		ctx: ctx,
+		Server: http.Server{
+			Addr:           addr,
+			ReadTimeout:    crlServerReadTimeout,
+			WriteTimeout:   crlServerWriteTimeout,
+			MaxHeaderBytes: crlServerMaxHeaderBytes,
+			Handler:        router,
+		},
+		issuerLister:             issuerLister,
+		clusterIssuerLister:      clusterIssuerLister,
+		secretLister:             secretLister,
+		clusterResourceNamespace: clusterResourceNamespace,
+	}
+
+	router.HandleFunc("/crl/{namespace}/{name}", s.handleIssuer).Methods(http.MethodGet)
+	router.HandleFunc("/crl/{name}", s.handleClusterIssuer).Methods(http.MethodGet)
+
+	return s
+}
+
+func (s *Server) handleIssuer(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	iss, err := s.issuerLister.Issuers(vars["namespace"]).Get(vars["name"])
+	if err != nil {
+		s.handleError(w, err)
+		return
+	}
+	s.serveCRL(w, iss, iss.Namespace)
+}
+
+func (s *Server) handleClusterIssuer(w http.ResponseWriter, r *http.Request) {
+	if s.clusterIssuerLister == nil {
+		http.NotFound(w, r)
+		return
+	}
+	iss, err := s.clusterIssuerLister.Get(mux.Vars(r)["name"])
+	if err != nil {
+		s.handleError(w, err)
+		return
+	}
+	s.serveCRL(w, iss, s.clusterResourceNamespace)
+}
+
+func (s *Server) serveCRL(w http.ResponseWriter, iss v1alpha1.GenericIssuer, namespace string) {
+	ca := iss.GetSpec().CA
+	if ca == nil || ca.CRL == nil {
+		http.Error(w, "issuer does not have a certificate revocation list", http.StatusNotFound)
+		return
+	}
+
+	secret, err := s.secretLister.Secrets(namespace).Get(ca.CRL.SecretName)
+	if err != nil {
+		s.handleError(w, err)
+		return
+	}
+	crl := secret.Data[v1alpha1.CRLSecretKey]
+	if len(crl) == 0 {
+		http.Error(w, "certificate revocation list has not been generated", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentTypeCRL)
+	w.Write(crl)
+}
+
+func (s *Server) handleError(w http.ResponseWriter, err error) {
+	if apierrors.IsNotFound(err) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	logf.FromContext(s.ctx).Error(err, "error serving certificate revocation list")
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+func (s *Server) waitShutdown(stopCh <-chan struct{}) {
+	log := logf.FromContext(s.ctx)
+	<-stopCh
+	log.Info("stopping CRL server...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), crlServerShutdownTimeout)
+	defer cancel()
+
+	if err := s.Shutdown(ctx); err != nil {
+		log.Error(err, "CRL server shutdown failed")
+		return
+	}
+
+	log.Info("CRL server gracefully stopped")
+}
+
+// Start runs the server until stopCh is closed.
+func (s *Server) Start(stopCh <-chan struct{}) {
+	log := logf.FromContext(s.ctx)
+
+	go func() {
+		log := log.WithValues("address", s.Addr)
+		log.Info("listening for connections on")
+		if err := s.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error(err, "error running CRL server")
+			return
+		}
+
+		log.Info("CRL server exited")
+	}()
+
+	s.waitShutdown(stopCh)
+}
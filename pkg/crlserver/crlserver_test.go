@@ -0,0 +1,132 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crlserver
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func newIndexer(t *testing.T, objs ...interface{}) cache.Indexer {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, obj := range objs {
+		if err := indexer.Add(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return indexer
+}
+
+func TestServeCRL(t *testing.T) {
+	crlDER := []byte("crl")
+	caWithCRL := gen.SetIssuerCA(v1alpha1.CAIssuer{
+		SecretName: "ca",
+		CRL:        &v1alpha1.CACRLConfig{SecretName: "ca-crl"},
+	})
+	crlSecret := func(namespace string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "ca-crl"},
+			Data:       map[string][]byte{v1alpha1.CRLSecretKey: crlDER},
+		}
+	}
+
+	issuerLister := cmlisters.NewIssuerLister(newIndexer(t,
+		gen.Issuer("ca", caWithCRL),
+		gen.Issuer("no-crl", gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "ca"})),
+		gen.Issuer("not-generated", gen.SetIssuerCA(v1alpha1.CAIssuer{
+			SecretName: "ca",
+			CRL:        &v1alpha1.CACRLConfig{SecretName: "missing"},
+		})),
+	))
+	clusterIssuerLister := cmlisters.NewClusterIssuerLister(newIndexer(t,
+		gen.ClusterIssuer("ca", caWithCRL),
+	))
+	secretLister := corelisters.NewSecretLister(newIndexer(t,
+		crlSecret(gen.DefaultTestNamespace),
+		crlSecret("cluster-resources"),
+	))
+
+	tests := map[string]struct {
+		path           string
+		noClusterScope bool
+		expCode        int
+	}{
+		"serve the CRL of an Issuer": {
+			path:    "/crl/" + gen.DefaultTestNamespace + "/ca",
+			expCode: http.StatusOK,
+		},
+		"serve the CRL of a ClusterIssuer": {
+			path:    "/crl/ca",
+			expCode: http.StatusOK,
+		},
+		"not found if the Issuer does not exist": {
+			path:    "/crl/" + gen.DefaultTestNamespace + "/missing",
+			expCode: http.StatusNotFound,
+		},
+		"not found if the Issuer does not have a CRL configured": {
+			path:    "/crl/" + gen.DefaultTestNamespace + "/no-crl",
+			expCode: http.StatusNotFound,
+		},
+		"not found if the CRL has not been generated": {
+			path:    "/crl/" + gen.DefaultTestNamespace + "/not-generated",
+			expCode: http.StatusNotFound,
+		},
+		"not found for a ClusterIssuer if ClusterIssuers are disabled": {
+			path:           "/crl/ca",
+			noClusterScope: true,
+			expCode:        http.StatusNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cil := clusterIssuerLister
+			if test.noClusterScope {
+				cil = nil
+			}
+			s := New(context.Background(), "", issuerLister, cil, secretLister, "cluster-resources")
+
+			rec := httptest.NewRecorder()
+			s.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, test.path, nil))
+
+			if rec.Code != test.expCode {
+				t.Fatalf("expected status code %d, got %d: %s", test.expCode, rec.Code, rec.Body.String())
+			}
+			if test.expCode != http.StatusOK {
+				return
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != contentTypeCRL {
+				t.Errorf("expected content type %q, got %q", contentTypeCRL, ct)
+			}
+			if !bytes.Equal(rec.Body.Bytes(), crlDER) {
+				t.Errorf("expected CRL %q, got %q", crlDER, rec.Body.Bytes())
+			}
+		})
+	}
+}
@@ -26,6 +26,13 @@ const (
 	//
 	// ValidateCAA enables CAA checking when issuing certificates
 	ValidateCAA feature.Feature = "ValidateCAA"
+
+	// alpha: v0.9.0
+	//
+	// CertificateRequestControllers enables the Certificate controller to
+	// obtain certificates by creating CertificateRequest resources, rather than
+	// calling the issuer directly
+	CertificateRequestControllers feature.Feature = "CertificateRequestControllers"
 )
 
 func init() {
@@ -36,5 +43,6 @@ func init() {
 // To add a new feature, define a key for it above and add it here. The features will be
 // available throughout Kubernetes binaries.
 var defaultKubernetesFeatureGates = map[feature.Feature]feature.FeatureSpec{
-	ValidateCAA: {Default: false, PreRelease: feature.Alpha},
+	ValidateCAA:                   {Default: false, PreRelease: feature.Alpha},
+	CertificateRequestControllers: {Default: false, PreRelease: feature.Alpha},
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package acme
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/x509"
@@ -33,6 +34,7 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/jetstack/cert-manager/pkg/acme"
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
@@ -41,10 +43,6 @@ import (
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
-const (
-	createOrderWaitDuration = time.Hour * 1
-)
-
 var (
 	certificateGvk = v1alpha1.SchemeGroupVersion.WithKind("Certificate")
 )
@@ -148,18 +146,24 @@ func (a *Acme) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.Is
 	// If the existing order has failed, we should check if the Certificate
 	// already has a LastFailureTime
 	// - If it does not, then this is a new failure and we record the LastFailureTime
-	//   as Now() and return
+	//   as Now(), increment the number of failed issuance attempts and return
 	// - If it does, and it is more than the 'back-off' period ago, we retry the order
 	// - Otherwise we return an error to attempt re-processing at a later time
+	// The 'back-off' period grows exponentially with the number of consecutive
+	// failed issuance attempts, to avoid hitting ACME server rate limits.
 	if acme.IsFailureState(existingOrder.Status.State) {
 		if crt.Status.LastFailureTime == nil {
 			nowTime := metav1.NewTime(a.clock.Now())
 			crt.Status.LastFailureTime = &nowTime
-			a.Recorder.Eventf(crt, corev1.EventTypeWarning, "FailedOrder", "Order %q failed. Waiting %s before retrying issuance.", existingOrder.Name, createOrderWaitDuration)
+			crt.Status.FailedIssuanceAttempts++
+			backoff := apiutil.CertificateIssuanceBackoff(crt)
+			a.Recorder.Eventf(crt, corev1.EventTypeWarning, "FailedOrder", "Order %q failed. Waiting %s before retrying issuance at %s.",
+				existingOrder.Name, backoff, nowTime.Add(backoff).Format(time.RFC3339))
 		}
 
-		if time.Now().Sub(crt.Status.LastFailureTime.Time) < createOrderWaitDuration {
-			return nil, fmt.Errorf("applying acme order back-off for certificate %s/%s because it has failed within the last %s", crt.Namespace, crt.Name, createOrderWaitDuration)
+		backoff := apiutil.CertificateIssuanceBackoff(crt)
+		if a.clock.Now().Sub(crt.Status.LastFailureTime.Time) < backoff {
+			return nil, fmt.Errorf("applying acme order back-off for certificate %s/%s because it has failed within the last %s", crt.Namespace, crt.Name, backoff)
 		}
 
 		return nil, a.retryOrder(crt, existingOrder)
@@ -193,6 +197,17 @@ func (a *Acme) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.Is
 	// x509Cert := x509Certs[0]
 	x509Cert := x509Certs
 
+	// if re-issuance has been manually triggered, the certificate on the
+	// existing order cannot be used if it is the one that is already stored
+	// in the target secret, so we recreate the order to obtain a new one.
+	if apiutil.CertificateIssuanceRequested(crt) {
+		existingCert, err := kube.SecretTLSCert(ctx, a.secretsLister, crt.Namespace, crt.Spec.SecretName)
+		if err == nil && bytes.Equal(existingCert.Raw, x509Cert.Raw) {
+			a.Recorder.Eventf(crt, corev1.EventTypeNormal, "OrderRecreated", "Re-issuance manually triggered. Creating new order...")
+			return nil, a.retryOrder(crt, existingOrder)
+		}
+	}
+
 	// we check if the certificate stored on the existing order resource is
 	// nearing expiry.
 	// If it is, we recreate the order so we can obtain a fresh certificate.
@@ -274,12 +289,13 @@ func (a *Acme) getCertificatePrivateKey(ctx context.Context, crt *v1alpha1.Certi
 
 	log.V(4).Info("attempting to fetch existing certificate private key")
 
-	// If a private key already exists, reuse it.
+	// If a private key already exists, reuse it. If the private key is being
+	// rotated, the pending private key will be returned instead.
 	// TODO: if we have not observed the update to the Secret resource with the
 	// private key yet, we may in some cases loop and re-generate the private key
 	// over and over. We could attempt to use the live clientset to read the
 	// private key too to avoid this case.
-	key, err := kube.SecretTLSKey(ctx, a.secretsLister, crt.Namespace, crt.Spec.SecretName)
+	key, err := kube.SecretTLSKeyForCertificate(ctx, a.secretsLister, crt)
 	if err == nil {
 		return key, false, nil
 	}
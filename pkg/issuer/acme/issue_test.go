@@ -151,6 +151,16 @@ func TestIssueHappyPath(t *testing.T) {
 	testCertValidOrder.Status.Certificate = testCertSignedBytesPEM
 	testCertExpiredCertOrder := testCertValidOrder.DeepCopy()
 	testCertExpiredCertOrder.Status.Certificate = testCertExpiringSignedBytesPEM
+	testCertIssuanceRequested := testCert.DeepCopy()
+	testCertIssuanceRequested.Status.Conditions = []v1alpha1.CertificateCondition{
+		{
+			Type:   v1alpha1.CertificateConditionIssuing,
+			Status: v1alpha1.ConditionTrue,
+			Reason: v1alpha1.CertificateReasonManuallyTriggered,
+		},
+	}
+	testCertIssuedSecret := testCertPrivateKeySecret.DeepCopy()
+	testCertIssuedSecret.Data["tls.crt"] = testCertSignedBytesPEM
 
 	tests := map[string]acmeFixture{
 		"generate a new private key if one does not exist": {
@@ -284,6 +294,25 @@ func TestIssueHappyPath(t *testing.T) {
 			},
 			Err: false,
 		},
+		"recreate the order if re-issuance was manually triggered and the order's certificate is already stored": {
+			Certificate: testCertIssuanceRequested,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testCertValidOrder},
+				KubeObjects:        []runtime.Object{testCertIssuedSecret},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(
+						coretesting.NewDeleteAction(v1alpha1.SchemeGroupVersion.WithResource("orders"), testCertValidOrder.Namespace, testCertValidOrder.Name),
+					),
+				},
+			},
+			CheckFn: func(t *testing.T, s *acmeFixture, args ...interface{}) {
+				resp := args[1].(*issuer.IssueResponse)
+				if resp != nil {
+					t.Errorf("expected IssuerResponse to be nil")
+				}
+			},
+			Err: false,
+		},
 	}
 
 	for name, test := range tests {
@@ -358,6 +387,14 @@ func TestIssueRetryCases(t *testing.T) {
 
 	recentlyFailedCertificate := testCert.DeepCopy()
 	recentlyFailedCertificate.Status.LastFailureTime = &nowMetaTime
+	recentlyFailedCertificate.Status.FailedIssuanceAttempts = 1
+
+	// a certificate that has failed three times must wait four hours before
+	// retrying
+	repeatedlyFailedCertificate := testCert.DeepCopy()
+	threeHoursAgo := metav1.NewTime(nowMetaTime.Add(time.Hour * -3))
+	repeatedlyFailedCertificate.Status.LastFailureTime = &threeHoursAgo
+	repeatedlyFailedCertificate.Status.FailedIssuanceAttempts = 3
 
 	notRecentlyFailedCertificate := testCert.DeepCopy()
 	pastTime := metav1.NewTime(time.Now().Add(time.Hour * -24))
@@ -537,6 +574,28 @@ func TestIssueRetryCases(t *testing.T) {
 			Err: true,
 		},
 
+		"should exponentially back-off retrying a certificate that has repeatedly failed": {
+			Certificate: repeatedlyFailedCertificate,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{failedTestOrderCSR1},
+				KubeObjects:        []runtime.Object{testCertExistingPKSecret},
+				ExpectedActions:    []testpkg.Action{},
+			},
+			CheckFn: func(t *testing.T, s *acmeFixture, args ...interface{}) {
+				returnedCert := args[0].(*v1alpha1.Certificate)
+				resp := args[1].(*issuer.IssueResponse)
+
+				if resp != nil {
+					t.Errorf("expected IssuerResponse to be nil")
+				}
+				// the resource should not be changed
+				if !reflect.DeepEqual(returnedCert, repeatedlyFailedCertificate) {
+					t.Errorf("expected certificate to be unchanged: %s", pretty.Diff(returnedCert, repeatedlyFailedCertificate))
+				}
+			},
+			Err: true,
+		},
+
 		"set the last failure time if the order has failed and there is not a failure time set": {
 			Certificate: testCert,
 			Builder: &testpkg.Builder{
@@ -0,0 +1,141 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/jetstack/cert-manager/pkg/acme"
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+var (
+	certificateRequestGvk = v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.CertificateRequestKind)
+)
+
+// Sign will obtain a certificate for the certificate signing request contained
+// in the given CertificateRequest by creating an Order resource owned by the
+// CertificateRequest, and waiting for it to be completed.
+// Unlike Issue, a failed Order is not retried. Instead, the
+// CertificateRequest will be marked as failed and a new CertificateRequest
+// must be created to re-attempt issuance.
+func (a *Acme) Sign(ctx context.Context, cr *v1alpha1.CertificateRequest) (*issuer.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+
+	expectedOrder, err := buildOrderForCertificateRequest(cr)
+	if err != nil {
+		a.Recorder.Eventf(cr, corev1.EventTypeWarning, "Unknown", "Error building Order resource: %v", err)
+		return nil, err
+	}
+	log = logf.WithRelatedResource(log, expectedOrder)
+
+	existingOrder, err := a.orderLister.Orders(expectedOrder.Namespace).Get(expectedOrder.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "error getting existing Order resource")
+		return nil, err
+	}
+	if existingOrder == nil {
+		o, err := a.CMClient.CertmanagerV1alpha1().Orders(expectedOrder.Namespace).Create(expectedOrder)
+		if err != nil {
+			a.Recorder.Eventf(cr, corev1.EventTypeWarning, "CreateError", "Failed to create Order resource: %v", err)
+			return nil, err
+		}
+
+		msg := fmt.Sprintf("Created Order resource %q", o.Name)
+		a.Recorder.Event(cr, corev1.EventTypeNormal, "OrderCreated", msg)
+		apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady, v1alpha1.ConditionFalse, v1alpha1.CertificateRequestReasonPending, msg)
+		log.V(logf.DebugLevel).Info("created new Order resource for CertificateRequest")
+
+		return nil, nil
+	}
+
+	if acme.IsFailureState(existingOrder.Status.State) {
+		msg := fmt.Sprintf("Order %q is in %q state: %s", existingOrder.Name, existingOrder.Status.State, existingOrder.Status.Reason)
+		a.Recorder.Event(cr, corev1.EventTypeWarning, "OrderFailed", msg)
+		apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady, v1alpha1.ConditionFalse, v1alpha1.CertificateRequestReasonFailed, msg)
+		return nil, nil
+	}
+
+	if existingOrder.Status.State != v1alpha1.Valid {
+		log.Info("Order is not in 'valid' state. Waiting for Order to transition before attempting to issue Certificate.")
+		apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady, v1alpha1.ConditionFalse, v1alpha1.CertificateRequestReasonPending,
+			fmt.Sprintf("Waiting on certificate issuance from Order %q: %q", existingOrder.Name, existingOrder.Status.State))
+
+		// We don't immediately requeue, as the change to the Order resource on
+		// transition should trigger the CertificateRequest to be re-synced.
+		return nil, nil
+	}
+
+	// this should never happen
+	if existingOrder.Status.Certificate == nil {
+		a.Recorder.Eventf(cr, corev1.EventTypeWarning, "NoCertificate", "Empty certificate data retrieved from ACME server")
+		return nil, fmt.Errorf("order in a valid state but certificate data not set")
+	}
+
+	a.Recorder.Eventf(cr, corev1.EventTypeNormal, "OrderComplete", "Order %q completed successfully", existingOrder.Name)
+
+	return &issuer.IssueResponse{
+		Certificate: existingOrder.Status.Certificate,
+	}, nil
+}
+
+// buildOrderForCertificateRequest will build an Order resource for the given
+// CertificateRequest. The common name and DNS names are read from the
+// certificate signing request itself.
+func buildOrderForCertificateRequest(cr *v1alpha1.CertificateRequest) (*v1alpha1.Order, error) {
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.CSRPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := v1alpha1.OrderSpec{
+		CSR:        csr.Raw,
+		IssuerRef:  cr.Spec.IssuerRef,
+		CommonName: csr.Subject.CommonName,
+		DNSNames:   csr.DNSNames,
+	}
+	hash, err := hashOrder(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	// copy across labels from the CertificateRequest resource onto the Order.
+	// These are used when selecting which challenge solver to use.
+	lbls := make(map[string]string, len(cr.Labels))
+	for k, v := range cr.Labels {
+		lbls[k] = v
+	}
+
+	return &v1alpha1.Order{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-%d", cr.Name, hash),
+			Namespace:       cr.Namespace,
+			Labels:          lbls,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cr, certificateRequestGvk)},
+		},
+		Spec: spec,
+	}, nil
+}
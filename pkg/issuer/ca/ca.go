@@ -17,7 +17,10 @@ limitations under the License.
 package ca
 
 import (
+	"crypto/x509"
+
 	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/clock"
 
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
@@ -37,6 +40,9 @@ type CA struct {
 	// For Issuers, this will be the namespace of the Issuer.
 	// For ClusterIssuers, this will be the cluster resource namespace.
 	resourceNamespace string
+
+	// used for testing
+	clock clock.Clock
 }
 
 func NewCA(ctx *controller.Context, issuer v1alpha1.GenericIssuer) (issuer.Interface, error) {
@@ -47,9 +53,19 @@ func NewCA(ctx *controller.Context, issuer v1alpha1.GenericIssuer) (issuer.Inter
 		issuer:            issuer,
 		secretsLister:     secretsLister,
 		resourceNamespace: ctx.IssuerOptions.ResourceNamespace(issuer),
+		clock:             clock.RealClock{},
 	}, nil
 }
 
+// setIssuerURLs sets the CRL distribution points, OCSP servers and issuing
+// certificate URLs configured on the Issuer on the given certificate template.
+func (c *CA) setIssuerURLs(template *x509.Certificate) {
+	spec := c.issuer.GetSpec().CA
+	template.CRLDistributionPoints = spec.CRLDistributionPoints
+	template.OCSPServer = spec.OCSPServers
+	template.IssuingCertificateURL = spec.IssuingCertificateURLs
+}
+
 func init() {
 	issuer.RegisterIssuer(apiutil.IssuerCA, NewCA)
 }
@@ -0,0 +1,156 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	reasonCRLUpdated = "CRLUpdated"
+	reasonErrorCRL   = "ErrorCRL"
+)
+
+// syncCRL ensures that the Secret named on the Issuer's CRL configuration
+// contains a CRL, signed by the CA, that lists every revoked certificate
+// recorded in the Issuer's status. A new CRL is generated if the existing one
+// is missing, was signed by a different CA, does not list the same revoked
+// certificates, or if the configured update period has elapsed since it was
+// generated.
+func (c *CA) syncCRL(ctx context.Context, caCert *x509.Certificate, caKey crypto.Signer) error {
+	crlConfig := c.issuer.GetSpec().CA.CRL
+	log := logf.FromContext(ctx, "crl")
+	log = logf.WithRelatedResourceName(log, crlConfig.SecretName, c.resourceNamespace, "Secret")
+
+	if caCert.KeyUsage&x509.KeyUsageCRLSign == 0 {
+		return fmt.Errorf("signing CA certificate does not have the 'crl sign' key usage")
+	}
+
+	revoked, err := revokedCertificateEntries(c.issuer.GetStatus())
+	if err != nil {
+		return err
+	}
+
+	updatePeriod := v1alpha1.DefaultCRLUpdatePeriod
+	if crlConfig.UpdatePeriod != nil {
+		updatePeriod = crlConfig.UpdatePeriod.Duration
+	}
+
+	secret, err := c.secretsLister.Secrets(c.resourceNamespace).Get(crlConfig.SecretName)
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return err
+	}
+
+	now := c.clock.Now()
+	if secret != nil {
+		existing, err := x509.ParseCRL(secret.Data[v1alpha1.CRLSecretKey])
+		if err == nil && !crlNeedsUpdate(existing, caCert, revoked, now, updatePeriod) {
+			log.V(logf.DebugLevel).Info("certificate revocation list is up to date")
+			return nil
+		}
+	}
+
+	crlDER, err := caCert.CreateCRL(rand.Reader, caKey, revoked, now, now.Add(2*updatePeriod))
+	if err != nil {
+		return fmt.Errorf("error creating certificate revocation list: %v", err)
+	}
+
+	if secret == nil {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crlConfig.SecretName,
+				Namespace: c.resourceNamespace,
+			},
+			Data: map[string][]byte{v1alpha1.CRLSecretKey: crlDER},
+		}
+		_, err = c.Client.CoreV1().Secrets(c.resourceNamespace).Create(secret)
+	} else {
+		secret = secret.DeepCopy()
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		secret.Data[v1alpha1.CRLSecretKey] = crlDER
+		_, err = c.Client.CoreV1().Secrets(c.resourceNamespace).Update(secret)
+	}
+	if err != nil {
+		return fmt.Errorf("error saving certificate revocation list: %v", err)
+	}
+
+	log.Info("updated certificate revocation list", "revoked", len(revoked))
+	c.Recorder.Eventf(c.issuer, corev1.EventTypeNormal, reasonCRLUpdated, "Updated certificate revocation list with %d revoked certificates", len(revoked))
+
+	return nil
+}
+
+// crlNeedsUpdate returns true if the given CRL was not signed by caCert, does
+// not list exactly the given revoked certificates, or was generated more than
+// updatePeriod ago.
+func crlNeedsUpdate(crl *pkix.CertificateList, caCert *x509.Certificate, revoked []pkix.RevokedCertificate, now time.Time, updatePeriod time.Duration) bool {
+	if err := caCert.CheckCRLSignature(crl); err != nil {
+		return true
+	}
+	if !now.Before(crl.TBSCertList.ThisUpdate.Add(updatePeriod)) {
+		return true
+	}
+	if len(crl.TBSCertList.RevokedCertificates) != len(revoked) {
+		return true
+	}
+	listed := make(map[string]bool)
+	for _, r := range crl.TBSCertList.RevokedCertificates {
+		listed[r.SerialNumber.String()] = true
+	}
+	for _, r := range revoked {
+		if !listed[r.SerialNumber.String()] {
+			return true
+		}
+	}
+	return false
+}
+
+// revokedCertificateEntries converts the revoked certificates recorded in an
+// Issuer's status to CRL entries.
+func revokedCertificateEntries(status *v1alpha1.IssuerStatus) ([]pkix.RevokedCertificate, error) {
+	if status.CA == nil {
+		return nil, nil
+	}
+	var entries []pkix.RevokedCertificate
+	for _, r := range status.CA.RevokedCertificates {
+		serial, ok := new(big.Int).SetString(r.SerialNumber, 16)
+		if !ok {
+			return nil, fmt.Errorf("invalid serial number for revoked certificate: %q", r.SerialNumber)
+		}
+		entries = append(entries, pkix.RevokedCertificate{
+			SerialNumber:   serial,
+			RevocationTime: r.RevocationTime.Time,
+		})
+	}
+	return entries, nil
+}
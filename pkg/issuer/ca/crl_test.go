@@ -0,0 +1,236 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func generateCRL(t *testing.T, caCert *x509.Certificate, caKey crypto.Signer, thisUpdate time.Time, serials ...int64) []byte {
+	var revoked []pkix.RevokedCertificate
+	for _, s := range serials {
+		revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: big.NewInt(s), RevocationTime: thisUpdate})
+	}
+	crlDER, err := caCert.CreateCRL(rand.Reader, caKey, revoked, thisUpdate, thisUpdate.Add(time.Hour*48))
+	if err != nil {
+		t.Fatalf("error creating CRL: %v", err)
+	}
+	return crlDER
+}
+
+// crlSecretMatch returns an action matcher that checks that the Secret being
+// saved contains a CRL generated at thisUpdate, listing the given serials.
+func crlSecretMatch(caCert *x509.Certificate, thisUpdate time.Time, serials ...int64) testpkg.ActionMatchFn {
+	return func(exp, act coretesting.Action) error {
+		secret := act.(coretesting.CreateAction).GetObject().(*corev1.Secret)
+		crl, err := x509.ParseCRL(secret.Data[v1alpha1.CRLSecretKey])
+		if err != nil {
+			return fmt.Errorf("expected a valid CRL to be saved: %v", err)
+		}
+		if err := caCert.CheckCRLSignature(crl); err != nil {
+			return fmt.Errorf("expected CRL to be signed by the CA: %v", err)
+		}
+		if !crl.TBSCertList.ThisUpdate.Equal(thisUpdate) {
+			return fmt.Errorf("expected CRL to be generated at %v but got %v", thisUpdate, crl.TBSCertList.ThisUpdate)
+		}
+		revoked := crl.TBSCertList.RevokedCertificates
+		if len(revoked) != len(serials) {
+			return fmt.Errorf("expected %d revoked certificates but got %d", len(serials), len(revoked))
+		}
+		for i, s := range serials {
+			if revoked[i].SerialNumber.Int64() != s {
+				return fmt.Errorf("expected revoked serial %d but got %s", s, revoked[i].SerialNumber)
+			}
+		}
+		return nil
+	}
+}
+
+func TestSyncCRL(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	caKey := generateRSAPrivateKey(t)
+	caDER, _ := generateSelfSignedCert(t, gen.Certificate("test-root-ca",
+		gen.SetCertificateCommonName("root-ca"),
+		gen.SetCertificateIsCA(true),
+		gen.SetCertificateKeyUsages(v1alpha1.UsageCRLSign),
+	), caKey, time.Hour*24*60)
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	noCRLSignDER, _ := generateSelfSignedCert(t, gen.Certificate("test-root-ca",
+		gen.SetCertificateCommonName("root-ca"),
+		gen.SetCertificateIsCA(true),
+	), caKey, time.Hour*24*60)
+	noCRLSignCert, err := x509.ParseCertificate(noCRLSignDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuerWithRevoked := func(serials ...string) v1alpha1.GenericIssuer {
+		iss := gen.Issuer("ca-issuer",
+			gen.SetIssuerCA(v1alpha1.CAIssuer{
+				SecretName: "root-ca-secret",
+				CRL:        &v1alpha1.CACRLConfig{SecretName: "root-ca-crl"},
+			}),
+		)
+		iss.GetStatus().CA = &v1alpha1.CAIssuerStatus{}
+		for _, s := range serials {
+			iss.GetStatus().CA.RevokedCertificates = append(iss.GetStatus().CA.RevokedCertificates, v1alpha1.RevokedCertificate{
+				SerialNumber:   s,
+				RevocationTime: metav1.NewTime(now),
+			})
+		}
+		return iss
+	}
+	crlSecret := func(crlDER []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "root-ca-crl",
+				Namespace: gen.DefaultTestNamespace,
+			},
+			Data: map[string][]byte{v1alpha1.CRLSecretKey: crlDER},
+		}
+	}
+	secretsResource := corev1.SchemeGroupVersion.WithResource("secrets")
+
+	tests := map[string]struct {
+		issuer v1alpha1.GenericIssuer
+		caCert *x509.Certificate
+		*testpkg.Builder
+		err bool
+	}{
+		"create a CRL if one does not exist": {
+			issuer: issuerWithRevoked("0A"),
+			caCert: caCert,
+			Builder: &testpkg.Builder{
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewCustomMatch(coretesting.NewCreateAction(secretsResource, gen.DefaultTestNamespace, nil),
+						crlSecretMatch(caCert, now, 10)),
+				},
+			},
+		},
+		"do nothing if the CRL is up to date": {
+			issuer: issuerWithRevoked("0A"),
+			caCert: caCert,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{crlSecret(generateCRL(t, caCert, caKey, now.Add(-time.Hour), 10))},
+			},
+		},
+		"regenerate the CRL if a certificate has been revoked": {
+			issuer: issuerWithRevoked("0A", "0B"),
+			caCert: caCert,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{crlSecret(generateCRL(t, caCert, caKey, now.Add(-time.Hour), 10))},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewCustomMatch(coretesting.NewUpdateAction(secretsResource, gen.DefaultTestNamespace, nil),
+						crlSecretMatch(caCert, now, 10, 11)),
+				},
+			},
+		},
+		"regenerate the CRL once the update period has passed": {
+			issuer: issuerWithRevoked(),
+			caCert: caCert,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{crlSecret(generateCRL(t, caCert, caKey, now.Add(-time.Hour*25)))},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewCustomMatch(coretesting.NewUpdateAction(secretsResource, gen.DefaultTestNamespace, nil),
+						crlSecretMatch(caCert, now)),
+				},
+			},
+		},
+		"fail if the CA certificate does not have the crl sign usage": {
+			issuer:  issuerWithRevoked(),
+			caCert:  noCRLSignCert,
+			Builder: &testpkg.Builder{},
+			err:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := &caFixture{Issuer: test.issuer, Builder: test.Builder}
+			s.Setup(t)
+			s.CA.clock = fakeclock.NewFakeClock(now)
+			err := s.CA.syncCRL(s.Ctx, test.caCert, caKey)
+			if err != nil != test.err {
+				t.Errorf("expected error %t, but got: %v", test.err, err)
+			}
+			s.Finish(t)
+		})
+	}
+}
+
+func TestPruneExpiredCertificates(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	expired := metav1.NewTime(now.Add(-time.Hour))
+	valid := metav1.NewTime(now.Add(time.Hour))
+
+	iss := gen.Issuer("ca-issuer", gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}))
+	iss.GetStatus().CA = &v1alpha1.CAIssuerStatus{
+		RevokedCertificates: []v1alpha1.RevokedCertificate{
+			{SerialNumber: "0A", RevocationTime: expired, NotAfter: &expired},
+			{SerialNumber: "0B", RevocationTime: expired, NotAfter: &valid},
+			{SerialNumber: "0C", RevocationTime: expired},
+		},
+		IssuedCertificates: []v1alpha1.IssuedCertificate{
+			{SerialNumber: "0D", NotAfter: expired},
+			{SerialNumber: "0E", NotAfter: valid},
+		},
+	}
+
+	if !apiutil.PruneExpiredCertificates(iss, now) {
+		t.Errorf("expected expired certificates to be pruned")
+	}
+	var revoked, issued []string
+	for _, r := range iss.Status.CA.RevokedCertificates {
+		revoked = append(revoked, r.SerialNumber)
+	}
+	for _, c := range iss.Status.CA.IssuedCertificates {
+		issued = append(issued, c.SerialNumber)
+	}
+	if !reflect.DeepEqual(revoked, []string{"0B", "0C"}) {
+		t.Errorf("expected revoked certificates [0B 0C] but got %v", revoked)
+	}
+	if !reflect.DeepEqual(issued, []string{"0E"}) {
+		t.Errorf("expected issued certificates [0E] but got %v", issued)
+	}
+
+	if apiutil.PruneExpiredCertificates(iss, now) {
+		t.Errorf("expected nothing to be pruned once expired certificates are removed")
+	}
+}
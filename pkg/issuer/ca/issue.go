@@ -47,8 +47,9 @@ func (c *CA) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.Issu
 	log := logf.FromContext(ctx, "issue")
 	log = logf.WithRelatedResourceName(log, crt.Spec.SecretName, crt.Namespace, "Secret")
 
-	// get a copy of the existing/currently issued Certificate's private key
-	signeeKey, err := kube.SecretTLSKey(ctx, c.secretsLister, crt.Namespace, crt.Spec.SecretName)
+	// get a copy of the existing/currently issued Certificate's private key,
+	// or the pending private key if the Certificate's key is being rotated
+	signeeKey, err := kube.SecretTLSKeyForCertificate(ctx, c.secretsLister, crt)
 	if k8sErrors.IsNotFound(err) || errors.IsInvalidData(err) {
 		log.Info("generating new private key")
 		// if one does not already exist, generate a new one
@@ -90,16 +91,23 @@ func (c *CA) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.Issu
 		return nil, err
 	}
 
+	c.setIssuerURLs(template)
+
 	caCert := caCerts[0]
 
 	// sign and encode the certificate
-	certPem, _, err := pki.SignCertificate(template, caCert, signeePublicKey, caKey)
+	certPem, cert, err := pki.SignCertificate(template, caCert, signeePublicKey, caKey)
 	if err != nil {
 		log.Error(err, "error signing certificate")
 		c.Recorder.Eventf(crt, corev1.EventTypeWarning, "ErrorSigning", "Error signing certificate: %v", err)
 		return nil, err
 	}
 
+	if err := c.recordIssuedCertificate(cert); err != nil {
+		log.Error(err, "error recording issued certificate in issuer status")
+		return nil, err
+	}
+
 	// encode the chain
 	// TODO: replace caCerts with caCerts[1:]?
 	chainPem, err := pki.EncodeX509Chain(caCerts)
@@ -140,11 +140,13 @@ func TestIssue(t *testing.T) {
 		},
 	}
 
+	caIssuer := gen.Issuer("ca-issuer",
+		gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
+	)
+
 	tests := map[string]caFixture{
 		"sign a Certificate and generate a new RSA private key": {
-			Issuer: gen.Issuer("ca-issuer",
-				gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
-			),
+			Issuer: caIssuer,
 			Certificate: gen.Certificate("test-crt",
 				gen.SetCertificateSecretName("crt-output"),
 				gen.SetCertificateCommonName("testing-cn"),
@@ -153,15 +155,14 @@ func TestIssue(t *testing.T) {
 			),
 			Builder: &testpkg.Builder{
 				KubeObjects:        []runtime.Object{rootRSACASecret},
-				CertManagerObjects: []runtime.Object{},
+				CertManagerObjects: []runtime.Object{caIssuer},
+				ExpectedActions:    issuedCertificateRecordedActions(caIssuer),
 			},
 			CheckFn: allFieldsSetCheck(rsaPEMCert),
 			Err:     false,
 		},
 		"sign a Certificate and generate a new ECDSA private key using RSA issuer": {
-			Issuer: gen.Issuer("ca-issuer",
-				gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
-			),
+			Issuer: caIssuer,
 			Certificate: gen.Certificate("test-crt",
 				gen.SetCertificateSecretName("crt-output"),
 				gen.SetCertificateCommonName("testing-cn"),
@@ -170,15 +171,14 @@ func TestIssue(t *testing.T) {
 			),
 			Builder: &testpkg.Builder{
 				KubeObjects:        []runtime.Object{rootRSACASecret},
-				CertManagerObjects: []runtime.Object{},
+				CertManagerObjects: []runtime.Object{caIssuer},
+				ExpectedActions:    issuedCertificateRecordedActions(caIssuer),
 			},
 			CheckFn: allFieldsSetCheck(rsaPEMCert),
 			Err:     false,
 		},
 		"sign a Certificate and generate a new RSA private key using ECDSA issuer": {
-			Issuer: gen.Issuer("ca-issuer",
-				gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
-			),
+			Issuer: caIssuer,
 			Certificate: gen.Certificate("test-crt",
 				gen.SetCertificateSecretName("crt-output"),
 				gen.SetCertificateCommonName("testing-cn"),
@@ -187,7 +187,8 @@ func TestIssue(t *testing.T) {
 			),
 			Builder: &testpkg.Builder{
 				KubeObjects:        []runtime.Object{rootECDSACASecret},
-				CertManagerObjects: []runtime.Object{},
+				CertManagerObjects: []runtime.Object{caIssuer},
+				ExpectedActions:    issuedCertificateRecordedActions(caIssuer),
 			},
 			CheckFn: allFieldsSetCheck(ecdsaPEMCert),
 			Err:     false,
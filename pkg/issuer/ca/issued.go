@@ -0,0 +1,60 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+package ca
+
+import (
+	"crypto/x509"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// recordIssuedCertificate records the given certificate in the status of the
+// Issuer, so that the OCSP responder can report it as good once the resource
+// it was issued for has been deleted. The certificate must not be returned if
+// it could not be recorded.
+func (c *CA) recordIssuedCertificate(cert *x509.Certificate) error {
+	serialNumber := pki.SerialNumberString(cert)
+	notAfter := metav1.NewTime(cert.NotAfter)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		switch iss := c.issuer.(type) {
+		case *v1alpha1.Issuer:
+			latest, err := c.CMClient.CertmanagerV1alpha1().Issuers(iss.Namespace).Get(iss.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			apiutil.RecordIssuedCertificate(latest, serialNumber, notAfter, c.clock.Now())
+			_, err = c.CMClient.CertmanagerV1alpha1().Issuers(latest.Namespace).Update(latest)
+			return err
+		case *v1alpha1.ClusterIssuer:
+			latest, err := c.CMClient.CertmanagerV1alpha1().ClusterIssuers().Get(iss.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			apiutil.RecordIssuedCertificate(latest, serialNumber, notAfter, c.clock.Now())
+			_, err = c.CMClient.CertmanagerV1alpha1().ClusterIssuers().Update(latest)
+			return err
+		}
+		return nil
+	})
+}
@@ -51,7 +51,7 @@ func (c *CA) Setup(ctx context.Context) error {
 		return err
 	}
 
-	_, err = kube.SecretTLSKey(ctx, c.secretsLister, c.resourceNamespace, c.issuer.GetSpec().CA.SecretName)
+	key, err := kube.SecretTLSKey(ctx, c.secretsLister, c.resourceNamespace, c.issuer.GetSpec().CA.SecretName)
 	if err != nil {
 		log.Error(err, "error getting signing CA private key")
 		s := messageErrorGetKeyPair + err.Error()
@@ -74,5 +74,19 @@ func (c *CA) Setup(ctx context.Context) error {
 	c.Recorder.Event(c.issuer, v1.EventTypeNormal, successKeyPairVerified, messageKeyPairVerified)
 	apiutil.SetIssuerCondition(c.issuer, v1alpha1.IssuerConditionReady, v1alpha1.ConditionTrue, successKeyPairVerified, messageKeyPairVerified)
 
+	// Expired certificates no longer need to be listed in the CRL or reported
+	// by the OCSP responder
+	if apiutil.PruneExpiredCertificates(c.issuer, c.clock.Now()) {
+		log.V(logf.DebugLevel).Info("removed expired certificates from issuer status")
+	}
+
+	if c.issuer.GetSpec().CA.CRL != nil {
+		if err := c.syncCRL(ctx, cert, key); err != nil {
+			log.Error(err, "error updating certificate revocation list")
+			c.Recorder.Eventf(c.issuer, v1.EventTypeWarning, reasonErrorCRL, "Error updating certificate revocation list: %v", err)
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,268 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func generateCSR(t *testing.T, crt *v1alpha1.Certificate, key crypto.Signer) []byte {
+	template, err := pki.GenerateCSR(nil, crt)
+	if err != nil {
+		t.Errorf("error generating csr template: %v", err)
+		t.FailNow()
+	}
+
+	derBytes, err := pki.EncodeCSR(template, key)
+	if err != nil {
+		t.Errorf("error encoding csr: %v", err)
+		t.FailNow()
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: derBytes})
+}
+
+func signedCertificateCheck(expectedCA []byte, key crypto.Signer, isCA bool) func(t *testing.T, s *caFixture, args ...interface{}) {
+	return func(t *testing.T, s *caFixture, args ...interface{}) {
+		resp := args[1].(*issuer.IssueResponse)
+
+		if resp.PrivateKey != nil {
+			t.Errorf("expected no private key to be returned")
+		}
+		if resp.CA == nil || !reflect.DeepEqual(expectedCA, resp.CA) {
+			t.Errorf("expected CA certificate to be returned")
+		}
+
+		cert, err := pki.DecodeX509CertificateBytes(resp.Certificate)
+		if err != nil {
+			t.Errorf("expected a valid certificate to be returned: %v", err)
+			return
+		}
+		matches, err := pki.PublicKeyMatchesCertificate(key.Public(), cert)
+		if err != nil || !matches {
+			t.Errorf("expected certificate to match the private key of the certificate request")
+		}
+		if cert.IsCA != isCA {
+			t.Errorf("expected certificate IsCA to be %t but got %t", isCA, cert.IsCA)
+		}
+		if cert.KeyUsage&x509.KeyUsageCertSign != 0 != isCA {
+			t.Errorf("expected certificate cert sign key usage to be %t", isCA)
+		}
+	}
+}
+
+func TestSign(t *testing.T) {
+	// Build root RSA CA
+	rsaPK := generateRSAPrivateKey(t)
+	rsaPKBytes := pki.EncodePKCS1PrivateKey(rsaPK)
+	rootRSACrt := gen.Certificate("test-root-ca",
+		gen.SetCertificateCommonName("root-ca"),
+		gen.SetCertificateIsCA(true),
+	)
+	// generate a self signed root ca valid for 60d
+	_, rsaPEMCert := generateSelfSignedCert(t, rootRSACrt, rsaPK, time.Hour*24*60)
+	rootRSACASecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "root-ca-secret",
+			Namespace: gen.DefaultTestNamespace,
+		},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: rsaPKBytes,
+			corev1.TLSCertKey:       rsaPEMCert,
+		},
+	}
+
+	exampleCrt := gen.Certificate("test-crt",
+		gen.SetCertificateCommonName("testing-cn"),
+	)
+	rsaCSRKey := generateRSAPrivateKey(t)
+	rsaCSR := generateCSR(t, exampleCrt, rsaCSRKey)
+	ecdsaCSRKey := generateECDSAPrivateKey(t)
+	ecdsaCSR := generateCSR(t, gen.CertificateFrom(exampleCrt,
+		gen.SetCertificateKeyAlgorithm(v1alpha1.ECDSAKeyAlgorithm),
+	), ecdsaCSRKey)
+
+	ed25519CSRKey, err := pki.GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Fatalf("error generating ed25519 private key: %v", err)
+	}
+	ed25519CSR := generateCSR(t, gen.CertificateFrom(exampleCrt,
+		gen.SetCertificateKeyAlgorithm(v1alpha1.Ed25519KeyAlgorithm),
+	), ed25519CSRKey)
+
+	caIssuer := gen.Issuer("ca-issuer",
+		gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
+	)
+	urlsIssuer := gen.Issuer("ca-issuer",
+		gen.SetIssuerCA(v1alpha1.CAIssuer{
+			SecretName:             "root-ca-secret",
+			CRLDistributionPoints:  []string{"http://ca.example.com/crl"},
+			OCSPServers:            []string{"http://ocsp.example.com"},
+			IssuingCertificateURLs: []string{"http://ca.example.com/ca.crt"},
+		}),
+	)
+
+	tests := map[string]caFixture{
+		"sign a CertificateRequest containing an RSA public key": {
+			Issuer: caIssuer,
+			CertificateRequest: gen.CertificateRequest("test-cr",
+				gen.SetCertificateRequestCSR(rsaCSR),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootRSACASecret},
+				CertManagerObjects: []runtime.Object{caIssuer},
+				ExpectedActions:    issuedCertificateRecordedActions(caIssuer),
+			},
+			CheckFn: signedCertificateCheck(rsaPEMCert, rsaCSRKey, false),
+			Err:     false,
+		},
+		"sign a CertificateRequest containing an ECDSA public key": {
+			Issuer: caIssuer,
+			CertificateRequest: gen.CertificateRequest("test-cr",
+				gen.SetCertificateRequestCSR(ecdsaCSR),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootRSACASecret},
+				CertManagerObjects: []runtime.Object{caIssuer},
+				ExpectedActions:    issuedCertificateRecordedActions(caIssuer),
+			},
+			CheckFn: signedCertificateCheck(rsaPEMCert, ecdsaCSRKey, false),
+			Err:     false,
+		},
+		"sign a CertificateRequest containing an Ed25519 public key": {
+			Issuer: caIssuer,
+			CertificateRequest: gen.CertificateRequest("test-cr",
+				gen.SetCertificateRequestCSR(ed25519CSR),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootRSACASecret},
+				CertManagerObjects: []runtime.Object{caIssuer},
+				ExpectedActions:    issuedCertificateRecordedActions(caIssuer),
+			},
+			CheckFn: signedCertificateCheck(rsaPEMCert, ed25519CSRKey, false),
+			Err:     false,
+		},
+		"sign a CertificateRequest for a CA certificate": {
+			Issuer: caIssuer,
+			CertificateRequest: gen.CertificateRequest("test-cr",
+				gen.SetCertificateRequestCSR(rsaCSR),
+				gen.SetCertificateRequestIsCA(true),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootRSACASecret},
+				CertManagerObjects: []runtime.Object{caIssuer},
+				ExpectedActions:    issuedCertificateRecordedActions(caIssuer),
+			},
+			CheckFn: signedCertificateCheck(rsaPEMCert, rsaCSRKey, true),
+			Err:     false,
+		},
+		"sign a CertificateRequest with CRL and OCSP URLs configured on the issuer": {
+			Issuer: urlsIssuer,
+			CertificateRequest: gen.CertificateRequest("test-cr",
+				gen.SetCertificateRequestCSR(rsaCSR),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootRSACASecret},
+				CertManagerObjects: []runtime.Object{urlsIssuer},
+				ExpectedActions:    issuedCertificateRecordedActions(urlsIssuer),
+			},
+			CheckFn: func(t *testing.T, s *caFixture, args ...interface{}) {
+				signedCertificateCheck(rsaPEMCert, rsaCSRKey, false)(t, s, args...)
+				resp := args[1].(*issuer.IssueResponse)
+				cert, err := pki.DecodeX509CertificateBytes(resp.Certificate)
+				if err != nil {
+					t.Errorf("expected a valid certificate to be returned: %v", err)
+					return
+				}
+				if !reflect.DeepEqual(cert.CRLDistributionPoints, []string{"http://ca.example.com/crl"}) {
+					t.Errorf("unexpected CRL distribution points: %v", cert.CRLDistributionPoints)
+				}
+				if !reflect.DeepEqual(cert.OCSPServer, []string{"http://ocsp.example.com"}) {
+					t.Errorf("unexpected OCSP servers: %v", cert.OCSPServer)
+				}
+				if !reflect.DeepEqual(cert.IssuingCertificateURL, []string{"http://ca.example.com/ca.crt"}) {
+					t.Errorf("unexpected issuing certificate URLs: %v", cert.IssuingCertificateURL)
+				}
+			},
+			Err: false,
+		},
+		"fail to sign if the certificate cannot be recorded in the issuer status": {
+			Issuer: caIssuer,
+			CertificateRequest: gen.CertificateRequest("test-cr",
+				gen.SetCertificateRequestCSR(rsaCSR),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootRSACASecret},
+				CertManagerObjects: []runtime.Object{},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewGetAction(
+						v1alpha1.SchemeGroupVersion.WithResource("issuers"),
+						caIssuer.Namespace,
+						caIssuer.Name,
+					)),
+				},
+			},
+			Err: true,
+		},
+		"fail to sign if the CA secret does not exist": {
+			Issuer: caIssuer,
+			CertificateRequest: gen.CertificateRequest("test-cr",
+				gen.SetCertificateRequestCSR(rsaCSR),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{},
+				CertManagerObjects: []runtime.Object{},
+			},
+			Err: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.Builder == nil {
+				test.Builder = &testpkg.Builder{}
+			}
+			test.Setup(t)
+			crCopy := test.CertificateRequest.DeepCopy()
+			resp, err := test.CA.Sign(test.Ctx, crCopy)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+
+			test.Finish(t, crCopy, resp, err)
+		})
+	}
+}
@@ -18,8 +18,11 @@ package ca
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	coretesting "k8s.io/client-go/testing"
+
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/jetstack/cert-manager/pkg/controller/test"
 )
@@ -33,8 +36,9 @@ type caFixture struct {
 	CA *CA
 	*test.Builder
 
-	Issuer      v1alpha1.GenericIssuer
-	Certificate *v1alpha1.Certificate
+	Issuer             v1alpha1.GenericIssuer
+	Certificate        *v1alpha1.Certificate
+	CertificateRequest *v1alpha1.CertificateRequest
 
 	PreFn   func(*testing.T, *caFixture)
 	CheckFn func(*testing.T, *caFixture, ...interface{})
@@ -101,3 +105,26 @@ func (s *caFixture) buildFakeCA(b *test.Builder, issuer v1alpha1.GenericIssuer)
 	b.Sync()
 	return caStruct
 }
+
+// issuedCertificateRecordedActions returns the actions expected when a
+// certificate signed by the given Issuer is recorded in its status.
+func issuedCertificateRecordedActions(iss *v1alpha1.Issuer) []test.Action {
+	return []test.Action{
+		test.NewAction(coretesting.NewGetAction(
+			v1alpha1.SchemeGroupVersion.WithResource("issuers"),
+			iss.Namespace,
+			iss.Name,
+		)),
+		test.NewCustomMatch(coretesting.NewUpdateAction(
+			v1alpha1.SchemeGroupVersion.WithResource("issuers"),
+			iss.Namespace,
+			iss,
+		), func(exp, actual coretesting.Action) error {
+			updated := actual.(coretesting.UpdateAction).GetObject().(*v1alpha1.Issuer)
+			if updated.Status.CA == nil || len(updated.Status.CA.IssuedCertificates) != 1 {
+				return fmt.Errorf("expected a single issued certificate to be recorded, got: %+v", updated.Status.CA)
+			}
+			return nil
+		}),
+	}
+}
@@ -31,6 +31,14 @@ type Interface interface {
 	// Issue attempts to issue a certificate as described by the certificate
 	// resource given
 	Issue(context.Context, *v1alpha1.Certificate) (*IssueResponse, error)
+
+	// Sign attempts to sign the x509 certificate signing request contained
+	// in the given CertificateRequest resource.
+	// If the request cannot be completed yet, a nil response and nil error
+	// should be returned and the issuer is expected to set an appropriate
+	// condition on the CertificateRequest. Only the Certificate and CA fields
+	// of the returned IssueResponse will be used.
+	Sign(context.Context, *v1alpha1.CertificateRequest) (*IssueResponse, error)
 }
 
 type IssueResponse struct {
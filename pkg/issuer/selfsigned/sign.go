@@ -0,0 +1,89 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selfsigned
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/kube"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// Sign will self-sign the certificate signing request contained in the given
+// CertificateRequest. As the private key is needed to sign the certificate,
+// the CertificateRequest must be annotated with the name of a Secret in the
+// same namespace containing the private key that was used to generate the
+// request.
+func (c *SelfSigned) Sign(ctx context.Context, cr *v1alpha1.CertificateRequest) (*issuer.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+
+	secretName := cr.Annotations[v1alpha1.CRPrivateKeyAnnotationKey]
+	if secretName == "" {
+		msg := fmt.Sprintf("Annotation %q missing or has an empty value", v1alpha1.CRPrivateKeyAnnotationKey)
+		c.Recorder.Event(cr, corev1.EventTypeWarning, "MissingAnnotation", msg)
+		apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady, v1alpha1.ConditionFalse, v1alpha1.CertificateRequestReasonFailed, msg)
+		return nil, nil
+	}
+	log = logf.WithRelatedResourceName(log, secretName, cr.Namespace, "Secret")
+
+	privateKey, err := kube.SecretTLSKey(ctx, c.secretsLister, cr.Namespace, secretName)
+	if err != nil {
+		log.Error(err, "error getting private key for certificate request")
+		return nil, err
+	}
+
+	// generate a x509 certificate template for this CertificateRequest
+	template, err := pki.GenerateTemplateFromCertificateRequest(cr)
+	if err != nil {
+		c.Recorder.Eventf(cr, corev1.EventTypeWarning, "ErrorSigning", "Error generating certificate template: %v", err)
+		return nil, err
+	}
+
+	// the referenced private key must be the counterpart to the public key
+	// contained in the certificate signing request
+	matches, err := pki.PublicKeyMatchesCertificate(privateKey.Public(), template)
+	if err != nil {
+		return nil, err
+	}
+	if !matches {
+		msg := fmt.Sprintf("Private key stored in Secret %q does not match the public key of the certificate request", secretName)
+		c.Recorder.Event(cr, corev1.EventTypeWarning, "ErrorKeyMatch", msg)
+		apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady, v1alpha1.ConditionFalse, v1alpha1.CertificateRequestReasonFailed, msg)
+		return nil, nil
+	}
+
+	// sign and encode the certificate
+	certPem, _, err := pki.SignCertificate(template, template, template.PublicKey, privateKey)
+	if err != nil {
+		c.Recorder.Eventf(cr, corev1.EventTypeWarning, "ErrorSigning", "Error signing certificate: %v", err)
+		return nil, err
+	}
+
+	log.Info("self signed certificate issued")
+
+	return &issuer.IssueResponse{
+		Certificate: certPem,
+		CA:          certPem,
+	}, nil
+}
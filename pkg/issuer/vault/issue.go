@@ -52,8 +52,9 @@ const (
 )
 
 func (v *Vault) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.IssueResponse, error) {
-	// get a copy of the existing/currently issued Certificate's private key
-	signeePrivateKey, err := kube.SecretTLSKey(ctx, v.secretsLister, crt.Namespace, crt.Spec.SecretName)
+	// get a copy of the existing/currently issued Certificate's private key,
+	// or the pending private key if the Certificate's key is being rotated
+	signeePrivateKey, err := kube.SecretTLSKeyForCertificate(ctx, v.secretsLister, crt)
 	if k8sErrors.IsNotFound(err) || errors.IsInvalidData(err) {
 		// if one does not already exist, generate a new one
 		signeePrivateKey, err = pki.GeneratePrivateKeyForCertificate(crt)
@@ -93,7 +94,7 @@ func (v *Vault) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.I
 		certDuration = crt.Spec.Duration.Duration
 	}
 
-	certPem, caPem, err := v.requestVaultCert(template.Subject.CommonName, certDuration, template.DNSNames, pki.IPAddressesToString(template.IPAddresses), pemRequestBuf.Bytes())
+	certPem, caPem, err := v.requestVaultCert(template.Subject.CommonName, certDuration, append(template.DNSNames, template.EmailAddresses...), pki.IPAddressesToString(template.IPAddresses), pki.URLsToString(template.URIs), crt.Spec.Usages, pemRequestBuf.Bytes())
 	if err != nil {
 		v.Recorder.Eventf(crt, corev1.EventTypeWarning, "ErrorSigning", "Failed to request certificate: %v", err)
 		return nil, err
@@ -214,24 +215,36 @@ func (v *Vault) requestTokenWithAppRoleRef(client *vault.Client, appRole *v1alph
 	return token, nil
 }
 
-func (v *Vault) requestVaultCert(commonName string, certDuration time.Duration, altNames []string, ipSans []string, csr []byte) ([]byte, []byte, error) {
+func (v *Vault) requestVaultCert(commonName string, certDuration time.Duration, altNames []string, ipSans []string, uriSans []string, usages []v1alpha1.KeyUsage, csr []byte) ([]byte, []byte, error) {
 
 	client, err := v.initVaultClient()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	klog.V(4).Infof("Vault certificate request for commonName %s altNames: %q ipSans: %q", commonName, altNames, ipSans)
+	klog.V(4).Infof("Vault certificate request for commonName %s altNames: %q ipSans: %q uriSans: %q", commonName, altNames, ipSans, uriSans)
 
 	parameters := map[string]string{
 		"common_name":          commonName,
 		"alt_names":            strings.Join(altNames, ","),
 		"ip_sans":              strings.Join(ipSans, ","),
+		"uri_sans":             strings.Join(uriSans, ","),
 		"ttl":                  certDuration.String(),
 		"csr":                  string(csr),
 		"exclude_cn_from_sans": "true",
 	}
 
+	// only override the key usages configured on the Vault role if the user
+	// has explicitly requested a set of usages
+	if len(usages) > 0 {
+		keyUsages, extKeyUsages, err := vaultKeyUsages(usages)
+		if err != nil {
+			return nil, nil, err
+		}
+		parameters["key_usage"] = strings.Join(keyUsages, ",")
+		parameters["ext_key_usage"] = strings.Join(extKeyUsages, ",")
+	}
+
 	url := path.Join("/v1", v.issuer.GetSpec().Vault.Path)
 
 	request := client.NewRequest("POST", url)
@@ -316,3 +329,54 @@ func (v *Vault) vaultTokenRef(name, key string) (string, error) {
 
 	return token, nil
 }
+
+var vaultKeyUsageNames = map[x509.KeyUsage]string{
+	x509.KeyUsageDigitalSignature:  "DigitalSignature",
+	x509.KeyUsageContentCommitment: "ContentCommitment",
+	x509.KeyUsageKeyEncipherment:   "KeyEncipherment",
+	x509.KeyUsageDataEncipherment:  "DataEncipherment",
+	x509.KeyUsageKeyAgreement:      "KeyAgreement",
+	x509.KeyUsageCertSign:          "CertSign",
+	x509.KeyUsageCRLSign:           "CRLSign",
+	x509.KeyUsageEncipherOnly:      "EncipherOnly",
+	x509.KeyUsageDecipherOnly:      "DecipherOnly",
+}
+
+var vaultExtKeyUsageNames = map[x509.ExtKeyUsage]string{
+	x509.ExtKeyUsageAny:                        "Any",
+	x509.ExtKeyUsageServerAuth:                 "ServerAuth",
+	x509.ExtKeyUsageClientAuth:                 "ClientAuth",
+	x509.ExtKeyUsageCodeSigning:                "CodeSigning",
+	x509.ExtKeyUsageEmailProtection:            "EmailProtection",
+	x509.ExtKeyUsageIPSECEndSystem:             "IPSECEndSystem",
+	x509.ExtKeyUsageIPSECTunnel:                "IPSECTunnel",
+	x509.ExtKeyUsageIPSECUser:                  "IPSECUser",
+	x509.ExtKeyUsageTimeStamping:               "TimeStamping",
+	x509.ExtKeyUsageOCSPSigning:                "OCSPSigning",
+	x509.ExtKeyUsageMicrosoftServerGatedCrypto: "MicrosoftServerGatedCrypto",
+	x509.ExtKeyUsageNetscapeServerGatedCrypto:  "NetscapeServerGatedCrypto",
+}
+
+// vaultKeyUsages converts the given usages into the key usage and extended
+// key usage names understood by Vault's key_usage and ext_key_usage
+// parameters.
+func vaultKeyUsages(usages []v1alpha1.KeyUsage) ([]string, []string, error) {
+	ku, eku, err := pki.BuildKeyUsages(usages, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keyUsages []string
+	for i := uint(0); i < 9; i++ {
+		if name, ok := vaultKeyUsageNames[x509.KeyUsage(1<<i)]; ok && ku&(1<<i) != 0 {
+			keyUsages = append(keyUsages, name)
+		}
+	}
+
+	var extKeyUsages []string
+	for _, e := range eku {
+		extKeyUsages = append(extKeyUsages, vaultExtKeyUsageNames[e])
+	}
+
+	return keyUsages, extKeyUsages, nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// Sign will submit the certificate signing request contained in the given
+// CertificateRequest to Vault's sign endpoint.
+func (v *Vault) Sign(ctx context.Context, cr *v1alpha1.CertificateRequest) (*issuer.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.CSRPEM)
+	if err != nil {
+		log.Error(err, "error decoding certificate request")
+		return nil, err
+	}
+
+	certDuration := v1alpha1.DefaultCertificateDuration
+	if cr.Spec.Duration != nil {
+		certDuration = cr.Spec.Duration.Duration
+	}
+
+	certPem, caPem, err := v.requestVaultCert(csr.Subject.CommonName, certDuration, append(csr.DNSNames, csr.EmailAddresses...), pki.IPAddressesToString(csr.IPAddresses), pki.URLsToString(csr.URIs), cr.Spec.Usages, cr.Spec.CSRPEM)
+	if err != nil {
+		v.Recorder.Eventf(cr, corev1.EventTypeWarning, "ErrorSigning", "Failed to request certificate: %v", err)
+		return nil, err
+	}
+
+	log.Info("certificate issued")
+
+	return &issuer.IssueResponse{
+		Certificate: certPem,
+		CA:          caPem,
+	}, nil
+}
@@ -18,6 +18,7 @@ package venafi
 
 import (
 	"context"
+	"crypto"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
@@ -29,8 +30,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog"
 
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/jetstack/cert-manager/pkg/issuer"
+	"github.com/jetstack/cert-manager/pkg/util/kube"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
@@ -40,8 +43,8 @@ const (
 
 // Issue will attempt to issue a new certificate from the Venafi Issuer.
 // The control flow is as follows:
-// - Attempt to retrieve the existing private key
-// 		- If it does not exist, generate one
+// - Generate a new private key, unless the Certificate uses a provided
+//   private key
 // - Generate a certificate template
 // - Read the zone configuration from the Venafi server
 // - Create a Venafi request based on the certificate template
@@ -52,18 +55,31 @@ const (
 func (v *Venafi) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.IssueResponse, error) {
 	v.Recorder.Event(crt, corev1.EventTypeNormal, "Issuing", "Requesting new certificate...")
 
-	// Always generate a new private key, as some Venafi configurations mandate
-	// unique private keys per issuance.
-	signeeKey, err := pki.GeneratePrivateKeyForCertificate(crt)
-	if err != nil {
-		klog.Errorf("Error generating private key %q for certificate: %v", crt.Spec.SecretName, err)
-		v.Recorder.Eventf(crt, corev1.EventTypeWarning, "PrivateKeyError", "Error generating certificate private key: %v", err)
-		// don't trigger a retry. An error from this function implies some
-		// invalid input parameters, and retrying without updating the
-		// resource will not help.
-		return nil, nil
+	var signeeKey crypto.Signer
+	var err error
+	if apiutil.PrivateKeySecretRef(crt) != nil {
+		// if the Certificate uses a provided private key, it will have been
+		// stored in the target secret by the certificates controller and a
+		// private key must never be generated
+		signeeKey, err = kube.SecretTLSKeyForCertificate(ctx, v.secretsLister, crt)
+		if err != nil {
+			klog.Errorf("Error getting provided private key %q for certificate: %v", crt.Spec.SecretName, err)
+			return nil, err
+		}
+	} else {
+		// Otherwise, always generate a new private key, as some Venafi
+		// configurations mandate unique private keys per issuance.
+		signeeKey, err = pki.GeneratePrivateKeyForCertificate(crt)
+		if err != nil {
+			klog.Errorf("Error generating private key %q for certificate: %v", crt.Spec.SecretName, err)
+			v.Recorder.Eventf(crt, corev1.EventTypeWarning, "PrivateKeyError", "Error generating certificate private key: %v", err)
+			// don't trigger a retry. An error from this function implies some
+			// invalid input parameters, and retrying without updating the
+			// resource will not help.
+			return nil, nil
+		}
+		v.Recorder.Event(crt, corev1.EventTypeNormal, "GenerateKey", "Generated new private key")
 	}
-	v.Recorder.Event(crt, corev1.EventTypeNormal, "GenerateKey", "Generated new private key")
 
 	// extract the public component of the key
 	signeePublicKey, err := pki.PublicKeyForPrivateKey(signeeKey)
@@ -0,0 +1,113 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package venafi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Venafi/vcert/pkg/certificate"
+	"github.com/Venafi/vcert/pkg/endpoint"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// Sign will submit the certificate signing request contained in the given
+// CertificateRequest to the Venafi Issuer.
+// The control flow is as follows:
+// - Build a certificate template from the certificate signing request
+// - Read the zone configuration from the Venafi server
+// - Create a Venafi request based on the certificate template
+// - Validate the request against the zone
+// - Submit the request, using the user provided certificate signing request
+// - Wait for the request to be fulfilled and the certificate to be available
+func (v *Venafi) Sign(ctx context.Context, cr *v1alpha1.CertificateRequest) (*issuer.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+
+	// We build a x509.Certificate as the vcert library has support for converting
+	// this into its own internal Certificate Request type.
+	tmpl, err := pki.GenerateTemplateFromCertificateRequest(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve a copy of the Venafi zone.
+	// This contains default values and policy control info that we can apply
+	// and check against locally.
+	zoneName := v.issuer.GetSpec().Venafi.Zone
+	zoneCfg, err := v.client.ReadZoneConfiguration(zoneName)
+	if err != nil {
+		v.Recorder.Eventf(cr, corev1.EventTypeWarning, "ReadZone", "Failed to read Venafi zone configuration: %v", err)
+		return nil, err
+	}
+
+	// Create a vcert Request structure. We do not apply the zone defaults
+	// here as the certificate signing request has already been signed.
+	vreq := newVRequest(tmpl)
+	err = zoneCfg.ValidateCertificateRequest(vreq)
+	if err != nil {
+		v.Recorder.Eventf(cr, corev1.EventTypeWarning, "Validate", "Failed to validate certificate request against Venafi zone: %v", err)
+		return nil, err
+	}
+
+	// We mark the request as having a user provided CSR, which will prevent
+	// a new private key being generated by vcert.
+	vreq.CSR = cr.Spec.CSRPEM
+	vreq.CsrOrigin = certificate.UserProvidedCSR
+	// TODO: better set the timeout here. Right now, we'll block for this amount of time.
+	vreq.Timeout = time.Minute * 5
+
+	requestID, err := v.client.RequestCertificate(vreq, zoneName)
+	if err != nil {
+		v.Recorder.Eventf(cr, corev1.EventTypeWarning, "Request", "Failed to request a certificate from Venafi: %v", err)
+		return nil, err
+	}
+
+	// Set the PickupID so vcert does not have to look it up by the fingerprint
+	vreq.PickupID = requestID
+
+	pemCollection, err := v.client.RetrieveCertificate(vreq)
+
+	// Check some known error types
+	if err, ok := err.(endpoint.ErrCertificatePending); ok {
+		v.Recorder.Eventf(cr, corev1.EventTypeWarning, "Retrieve", "Failed to retrieve a certificate from Venafi, still pending: %v", err)
+		return nil, fmt.Errorf("Venafi certificate still pending: %v", err)
+	}
+	if err, ok := err.(endpoint.ErrRetrieveCertificateTimeout); ok {
+		v.Recorder.Eventf(cr, corev1.EventTypeWarning, "Retrieve", "Failed to retrieve a certificate from Venafi, timed out: %v", err)
+		return nil, fmt.Errorf("Timed out waiting for certificate: %v", err)
+	}
+	if err != nil {
+		v.Recorder.Eventf(cr, corev1.EventTypeWarning, "Retrieve", "Failed to retrieve a certificate from Venafi: %v", err)
+		return nil, err
+	}
+
+	log.Info("certificate issued")
+
+	// Construct the certificate chain
+	cs := append([]string{pemCollection.Certificate}, pemCollection.Chain...)
+	chain := strings.Join(cs, "\n")
+	return &issuer.IssueResponse{
+		Certificate: []byte(chain),
+	}, nil
+}
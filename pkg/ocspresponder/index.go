@@ -0,0 +1,60 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocspresponder
+
+import (
+	"fmt"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// SerialNumberIndex is the name of the informer index used to look up
+// Certificates and CertificateRequests by the serial number of the
+// certificate issued for them.
+const SerialNumberIndex = "serialNumber"
+
+// CertificateSerialNumberIndexFunc indexes Certificates by the serial number
+// of their current certificate.
+func CertificateSerialNumberIndexFunc(obj interface{}) ([]string, error) {
+	crt, ok := obj.(*v1alpha1.Certificate)
+	if !ok {
+		return nil, fmt.Errorf("object is not a Certificate")
+	}
+	if crt.Status.SerialNumber == "" {
+		return nil, nil
+	}
+	return []string{crt.Status.SerialNumber}, nil
+}
+
+// CertificateRequestSerialNumberIndexFunc indexes CertificateRequests by the
+// serial number of their signed certificate.
+func CertificateRequestSerialNumberIndexFunc(obj interface{}) ([]string, error) {
+	cr, ok := obj.(*v1alpha1.CertificateRequest)
+	if !ok {
+		return nil, fmt.Errorf("object is not a CertificateRequest")
+	}
+	if len(cr.Status.Certificate) == 0 {
+		return nil, nil
+	}
+	cert, err := pki.DecodeX509CertificateBytes(cr.Status.Certificate)
+	if err != nil {
+		// the certificate is invalid, so cannot be looked up by serial number
+		return nil, nil
+	}
+	return []string{pki.SerialNumberString(cert)}, nil
+}
@@ -0,0 +1,430 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ocspresponder implements an OCSP responder (RFC 6960) for
+// certificates signed by CA Issuers and ClusterIssuers, using the issued and
+// revoked certificate state recorded by cert-manager.
+package ocspresponder
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/mux"
+	"golang.org/x/crypto/ocsp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1alpha1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/kube"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	responderShutdownTimeout = 5 * time.Second
+	responderReadTimeout     = 8 * time.Second
+	responderWriteTimeout    = 8 * time.Second
+	responderMaxHeaderBytes  = 1 << 20 // 1 MiB
+
+	// maxRequestBytes is the maximum size of an OCSP request body
+	maxRequestBytes = 1 << 16 // 64 KiB
+
+	contentTypeOCSPRequest  = "application/ocsp-request"
+	contentTypeOCSPResponse = "application/ocsp-response"
+)
+
+// Options configures a Responder.
+type Options struct {
+	// Address is the address to listen for OCSP requests on.
+	Address string
+
+	// ClusterResourceNamespace is the namespace that the Secrets referenced
+	// by ClusterIssuers are stored in.
+	ClusterResourceNamespace string
+
+	// ResponseValidity is the duration that each response is valid for, used
+	// to set the nextUpdate field of responses.
+	ResponseValidity time.Duration
+}
+
+// Responder is an HTTP server answering OCSP requests for certificates signed
+// by CA issuers. Requests for an Issuer are served at
+// /issuers/<namespace>/<name>, and for a ClusterIssuer at
+// /clusterissuers/<name>, using either the POST or GET method.
+type Responder struct {
+	ctx context.Context
+	http.Server
+
+	opts Options
+
+	issuerLister        cmlisters.IssuerLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	secretLister        corelisters.SecretLister
+
+	// certificateIndexer and certificateRequestIndexer must have the
+	// SerialNumberIndex index registered
+	certificateIndexer        cache.Indexer
+	certificateRequestIndexer cache.Indexer
+
+	// used for testing
+	clock clock.Clock
+}
+
+// New returns a new OCSP responder. clusterIssuerLister may be nil, in which
+// case requests for ClusterIssuers will not be answered.
+func New(ctx context.Context, opts Options,
+	issuerLister cmlisters.IssuerLister,
+	clusterIssuerLister cmlisters.ClusterIssuerLister,
+	secretLister corelisters.SecretLister,
+	certificateIndexer, certificateRequestIndexer cache.Indexer,
+) *Responder {
+	// OCSP GET requests contain a base64 encoded request in the path, which
+	// may contain characters that would otherwise be cleaned or decoded
+	router := mux.NewRouter().SkipClean(true).UseEncodedPath()
+
+	r := &Responder{
+		ctx: ctx,
+		Server: http.Server{
+			Addr:           opts.Address,
+			ReadTimeout:    responderReadTimeout,
+			WriteTimeout:   responderWriteTimeout,
+			MaxHeaderBytes: responderMaxHeaderBytes,
+			Handler:        router,
+		},
+		opts:                      opts,
+		issuerLister:              issuerLister,
+		clusterIssuerLister:       clusterIssuerLister,
+		secretLister:              secretLister,
+		certificateIndexer:        certificateIndexer,
+		certificateRequestIndexer: certificateRequestIndexer,
+		clock:                     clock.RealClock{},
+	}
+
+	router.HandleFunc("/issuers/{namespace}/{name}", r.handleIssuer).Methods(http.MethodPost)
+	router.HandleFunc("/issuers/{namespace}/{name}/{request:.+}", r.handleIssuer).Methods(http.MethodGet)
+	router.HandleFunc("/clusterissuers/{name}", r.handleClusterIssuer).Methods(http.MethodPost)
+	router.HandleFunc("/clusterissuers/{name}/{request:.+}", r.handleClusterIssuer).Methods(http.MethodGet)
+
+	return r
+}
+
+func (r *Responder) handleIssuer(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	iss, err := r.issuerLister.Issuers(vars["namespace"]).Get(vars["name"])
+	r.respond(w, req, iss, err, vars["namespace"])
+}
+
+func (r *Responder) handleClusterIssuer(w http.ResponseWriter, req *http.Request) {
+	if r.clusterIssuerLister == nil {
+		http.NotFound(w, req)
+		return
+	}
+	iss, err := r.clusterIssuerLister.Get(mux.Vars(req)["name"])
+	r.respond(w, req, iss, err, r.opts.ClusterResourceNamespace)
+}
+
+// respond answers an OCSP request for a certificate signed by the given
+// issuer, whose Secrets are stored in resourceNamespace. getErr is the error
+// returned when retrieving the issuer.
+func (r *Responder) respond(w http.ResponseWriter, req *http.Request, iss v1alpha1.GenericIssuer, getErr error, resourceNamespace string) {
+	log := logf.FromContext(r.ctx)
+
+	ocspReq, err := readRequest(req)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("received malformed OCSP request", "error", err.Error())
+		writeResponse(w, ocsp.MalformedRequestErrorResponse, 0)
+		return
+	}
+
+	if getErr != nil {
+		if !apierrors.IsNotFound(getErr) {
+			log.Error(getErr, "error getting issuer")
+			writeResponse(w, ocsp.InternalErrorErrorResponse, 0)
+			return
+		}
+		writeResponse(w, ocsp.UnauthorizedErrorResponse, 0)
+		return
+	}
+
+	log = logf.WithResource(log, iss).WithValues("serial_number", fmt.Sprintf("%X", ocspReq.SerialNumber))
+	ca := iss.GetSpec().CA
+	if ca == nil {
+		writeResponse(w, ocsp.UnauthorizedErrorResponse, 0)
+		return
+	}
+
+	caCerts, caKey, err := kube.SecretTLSKeyPair(r.ctx, r.secretLister, resourceNamespace, ca.SecretName)
+	if err != nil {
+		log.Error(err, "error getting CA key pair")
+		writeResponse(w, ocsp.InternalErrorErrorResponse, 0)
+		return
+	}
+	caCert := caCerts[0]
+
+	matches, err := issuerMatches(ocspReq, caCert)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("received malformed OCSP request", "error", err.Error())
+		writeResponse(w, ocsp.MalformedRequestErrorResponse, 0)
+		return
+	}
+	if !matches {
+		writeResponse(w, ocsp.UnauthorizedErrorResponse, 0)
+		return
+	}
+
+	responderCert, responderKey := caCert, caKey
+	if ca.OCSP != nil {
+		responderCert, responderKey, err = r.delegatedResponder(caCert, resourceNamespace, ca.OCSP.ResponderSecretName)
+		if err != nil {
+			log.Error(err, "error getting delegated OCSP responder key pair")
+			writeResponse(w, ocsp.InternalErrorErrorResponse, 0)
+			return
+		}
+	}
+
+	now := r.clock.Now()
+	template := ocsp.Response{
+		SerialNumber: ocspReq.SerialNumber,
+		IssuerHash:   ocspReq.HashAlgorithm,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(r.opts.ResponseValidity),
+	}
+	if revokedAt, ok := revocationTime(iss, ocspReq.SerialNumber); ok {
+		template.Status = ocsp.Revoked
+		template.RevokedAt = revokedAt
+		template.RevocationReason = ocsp.Unspecified
+	} else if r.issued(iss, ocspReq.SerialNumber) {
+		template.Status = ocsp.Good
+	} else {
+		template.Status = ocsp.Unknown
+	}
+	if responderCert != caCert {
+		template.Certificate = responderCert
+	}
+
+	resp, err := ocsp.CreateResponse(caCert, responderCert, template, responderKey)
+	if err != nil {
+		log.Error(err, "error signing OCSP response")
+		writeResponse(w, ocsp.InternalErrorErrorResponse, 0)
+		return
+	}
+
+	log.V(logf.DebugLevel).Info("answered OCSP request", "status", template.Status)
+	writeResponse(w, resp, r.opts.ResponseValidity)
+}
+
+// delegatedResponder returns the certificate and private key stored in the
+// named Secret, checking that the certificate has been signed by the CA and
+// is authorized to sign OCSP responses.
+func (r *Responder) delegatedResponder(caCert *x509.Certificate, namespace, name string) (*x509.Certificate, crypto.Signer, error) {
+	certs, key, err := kube.SecretTLSKeyPair(r.ctx, r.secretLister, namespace, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert := certs[0]
+
+	if err := cert.CheckSignatureFrom(caCert); err != nil {
+		return nil, nil, fmt.Errorf("delegated OCSP responder certificate is not signed by the CA: %v", err)
+	}
+	for _, usage := range cert.ExtKeyUsage {
+		if usage == x509.ExtKeyUsageOCSPSigning {
+			return cert, key, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("delegated OCSP responder certificate does not have the 'ocsp signing' usage")
+}
+
+// issued returns true if the certificate with the given serial number has
+// been issued by the given issuer. Certificates are looked up in the status
+// of the issuer, and in the Certificates and CertificateRequests that
+// reference it, for certificates issued before the status was recorded.
+func (r *Responder) issued(iss v1alpha1.GenericIssuer, serialNumber *big.Int) bool {
+	if status := iss.GetStatus().CA; status != nil {
+		for _, c := range status.IssuedCertificates {
+			issued, ok := new(big.Int).SetString(c.SerialNumber, 16)
+			if ok && issued.Cmp(serialNumber) == 0 {
+				return true
+			}
+		}
+	}
+
+	key := pki.SerialNumberString(&x509.Certificate{SerialNumber: serialNumber})
+
+	crts, err := r.certificateIndexer.ByIndex(SerialNumberIndex, key)
+	if err != nil {
+		logf.FromContext(r.ctx).Error(err, "error looking up Certificates by serial number")
+	}
+	for _, obj := range crts {
+		crt := obj.(*v1alpha1.Certificate)
+		if referencesIssuer(iss, crt.Namespace, crt.Spec.IssuerRef) {
+			return true
+		}
+	}
+
+	crs, err := r.certificateRequestIndexer.ByIndex(SerialNumberIndex, key)
+	if err != nil {
+		logf.FromContext(r.ctx).Error(err, "error looking up CertificateRequests by serial number")
+	}
+	for _, obj := range crs {
+		cr := obj.(*v1alpha1.CertificateRequest)
+		if referencesIssuer(iss, cr.Namespace, cr.Spec.IssuerRef) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// referencesIssuer returns true if ref, set on a resource in the given
+// namespace, refers to the given issuer.
+func referencesIssuer(iss v1alpha1.GenericIssuer, namespace string, ref v1alpha1.ObjectReference) bool {
+	if ref.Name != iss.GetObjectMeta().Name {
+		return false
+	}
+	switch iss.(type) {
+	case *v1alpha1.ClusterIssuer:
+		return ref.Kind == v1alpha1.ClusterIssuerKind
+	default:
+		return (ref.Kind == "" || ref.Kind == v1alpha1.IssuerKind) && namespace == iss.GetObjectMeta().Namespace
+	}
+}
+
+// revocationTime returns the time that the certificate with the given serial
+// number was revoked, if it has been revoked.
+func revocationTime(iss v1alpha1.GenericIssuer, serialNumber *big.Int) (time.Time, bool) {
+	status := iss.GetStatus().CA
+	if status == nil {
+		return time.Time{}, false
+	}
+	for _, r := range status.RevokedCertificates {
+		revoked, ok := new(big.Int).SetString(r.SerialNumber, 16)
+		if ok && revoked.Cmp(serialNumber) == 0 {
+			return r.RevocationTime.Time, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// issuerMatches returns true if the issuer name and key hashes of the OCSP
+// request identify the given CA certificate.
+func issuerMatches(req *ocsp.Request, caCert *x509.Certificate) (bool, error) {
+	if !req.HashAlgorithm.Available() {
+		return false, fmt.Errorf("unsupported hash algorithm %v", req.HashAlgorithm)
+	}
+
+	var publicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(caCert.RawSubjectPublicKeyInfo, &publicKeyInfo); err != nil {
+		return false, err
+	}
+
+	h := req.HashAlgorithm.New()
+	h.Write(caCert.RawSubject)
+	if !bytes.Equal(h.Sum(nil), req.IssuerNameHash) {
+		return false, nil
+	}
+
+	h.Reset()
+	h.Write(publicKeyInfo.PublicKey.RightAlign())
+	return bytes.Equal(h.Sum(nil), req.IssuerKeyHash), nil
+}
+
+// readRequest decodes the OCSP request from the body of a POST request, or
+// from the path of a GET request as described in RFC 6960 Appendix A.1.
+func readRequest(req *http.Request) (*ocsp.Request, error) {
+	var der []byte
+	switch req.Method {
+	case http.MethodGet:
+		encoded, err := url.PathUnescape(mux.Vars(req)["request"])
+		if err != nil {
+			return nil, err
+		}
+		if der, err = base64.StdEncoding.DecodeString(encoded); err != nil {
+			return nil, err
+		}
+	default:
+		if ct := req.Header.Get("Content-Type"); ct != "" && ct != contentTypeOCSPRequest {
+			return nil, fmt.Errorf("unexpected content type %q", ct)
+		}
+		var err error
+		if der, err = ioutil.ReadAll(io.LimitReader(req.Body, maxRequestBytes)); err != nil {
+			return nil, err
+		}
+	}
+	return ocsp.ParseRequest(der)
+}
+
+// writeResponse writes an encoded OCSP response. If maxAge is non-zero,
+// caches are permitted to store the response for that duration.
+func writeResponse(w http.ResponseWriter, resp []byte, maxAge time.Duration) {
+	w.Header().Set("Content-Type", contentTypeOCSPResponse)
+	if maxAge > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d, public, no-transform, must-revalidate", int(maxAge.Seconds())))
+	}
+	w.Write(resp)
+}
+
+func (r *Responder) waitShutdown(stopCh <-chan struct{}) {
+	log := logf.FromContext(r.ctx)
+	<-stopCh
+	log.Info("stopping OCSP responder...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), responderShutdownTimeout)
+	defer cancel()
+
+	if err := r.Shutdown(ctx); err != nil {
+		log.Error(err, "OCSP responder shutdown failed")
+		return
+	}
+
+	log.Info("OCSP responder gracefully stopped")
+}
+
+// Start runs the responder until stopCh is closed.
+func (r *Responder) Start(stopCh <-chan struct{}) {
+	log := logf.FromContext(r.ctx)
+
+	go func() {
+		log := log.WithValues("address", r.Addr)
+		log.Info("listening for connections on")
+		if err := r.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error(err, "error running OCSP responder")
+			return
+		}
+
+		log.Info("OCSP responder exited")
+	}()
+
+	r.waitShutdown(stopCh)
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocspresponder
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func newIndexer(t *testing.T, indexers cache.Indexers, objs ...interface{}) cache.Indexer {
+	indexers[cache.NamespaceIndex] = cache.MetaNamespaceIndexFunc
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, indexers)
+	for _, obj := range objs {
+		if err := indexer.Add(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return indexer
+}
+
+func generateKeyPair(t *testing.T, template *x509.Certificate, parent *x509.Certificate, parentKey crypto.Signer) (*x509.Certificate, crypto.Signer, []byte, []byte) {
+	key, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, err := pki.EncodeX509(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key, certPEM, pki.EncodePKCS1PrivateKey(key)
+}
+
+func TestRespond(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	notBefore, notAfter := now.Add(-time.Hour), now.Add(time.Hour)
+
+	caCert, caKey, caPEM, caKeyPEM := generateKeyPair(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	otherCACert, _, _, _ := generateKeyPair(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "other-ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	responderCert, _, responderPEM, responderKeyPEM := generateKeyPair(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "ocsp"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning},
+	}, caCert, caKey)
+	_, _, noUsagePEM, noUsageKeyPEM := generateKeyPair(t, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "not-ocsp"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}, caCert, caKey)
+	_, _, crCertPEM, _ := generateKeyPair(t, &x509.Certificate{
+		SerialNumber: big.NewInt(0x11),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}, caCert, caKey)
+
+	tlsSecret := func(namespace, name string, certPEM, keyPEM []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       certPEM,
+				corev1.TLSPrivateKeyKey: keyPEM,
+			},
+		}
+	}
+	caIssuer := func(ocspConfig *v1alpha1.CAOCSPConfig) gen.IssuerModifier {
+		return gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "ca", OCSP: ocspConfig})
+	}
+	revoked := func(iss v1alpha1.GenericIssuer) {
+		iss.GetStatus().CA = &v1alpha1.CAIssuerStatus{
+			RevokedCertificates: []v1alpha1.RevokedCertificate{
+				{SerialNumber: "0A", RevocationTime: metav1.NewTime(now.Add(-time.Minute))},
+			},
+			IssuedCertificates: []v1alpha1.IssuedCertificate{
+				{SerialNumber: "0F", NotAfter: metav1.NewTime(notAfter)},
+			},
+		}
+	}
+
+	issuerLister := cmlisters.NewIssuerLister(newIndexer(t, cache.Indexers{},
+		gen.Issuer("ca", caIssuer(nil), revoked),
+		gen.Issuer("delegated", caIssuer(&v1alpha1.CAOCSPConfig{ResponderSecretName: "ocsp"})),
+		gen.Issuer("invalid-delegated", caIssuer(&v1alpha1.CAOCSPConfig{ResponderSecretName: "not-ocsp"})),
+		gen.Issuer("self-signed", gen.SetIssuerSelfSigned(v1alpha1.SelfSignedIssuer{})),
+	))
+	clusterIssuerLister := cmlisters.NewClusterIssuerLister(newIndexer(t, cache.Indexers{},
+		gen.ClusterIssuer("ca", caIssuer(nil)),
+	))
+	secretLister := corelisters.NewSecretLister(newIndexer(t, cache.Indexers{},
+		tlsSecret(gen.DefaultTestNamespace, "ca", caPEM, caKeyPEM),
+		tlsSecret(gen.DefaultTestNamespace, "ocsp", responderPEM, responderKeyPEM),
+		tlsSecret(gen.DefaultTestNamespace, "not-ocsp", noUsagePEM, noUsageKeyPEM),
+		tlsSecret("cluster-resources", "ca", caPEM, caKeyPEM),
+	))
+	certificateIndexer := newIndexer(t, cache.Indexers{SerialNumberIndex: CertificateSerialNumberIndexFunc},
+		gen.Certificate("issued-by-issuer",
+			gen.SetCertificateIssuer(v1alpha1.ObjectReference{Name: "ca"}),
+			gen.SetCertificateSerialNumber("0D"),
+		),
+		gen.Certificate("issued-by-delegated",
+			gen.SetCertificateIssuer(v1alpha1.ObjectReference{Name: "delegated"}),
+			gen.SetCertificateSerialNumber("0C"),
+		),
+		gen.Certificate("issued-by-clusterissuer",
+			gen.SetCertificateIssuer(v1alpha1.ObjectReference{Name: "ca", Kind: v1alpha1.ClusterIssuerKind}),
+			gen.SetCertificateSerialNumber("0B"),
+		),
+	)
+	certificateRequestIndexer := newIndexer(t, cache.Indexers{SerialNumberIndex: CertificateRequestSerialNumberIndexFunc},
+		gen.CertificateRequest("issued-by-issuer",
+			gen.SetCertificateRequestIssuer(v1alpha1.ObjectReference{Name: "ca"}),
+			gen.SetCertificateRequestCertificate(crCertPEM),
+		),
+	)
+
+	ocspRequest := func(serial int64, issuer *x509.Certificate) []byte {
+		req, err := ocsp.CreateRequest(&x509.Certificate{SerialNumber: big.NewInt(serial)}, issuer, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+	issuerPath := "/issuers/" + gen.DefaultTestNamespace + "/"
+
+	tests := map[string]struct {
+		path    string
+		get     bool
+		request []byte
+
+		expStatus        int
+		expError         ocsp.ResponseStatus
+		expResponderCert *x509.Certificate
+	}{
+		"good status for a certificate issued for a Certificate": {
+			path:      issuerPath + "ca",
+			request:   ocspRequest(0x0D, caCert),
+			expStatus: ocsp.Good,
+		},
+		"good status for a certificate issued for a CertificateRequest": {
+			path:      issuerPath + "ca",
+			request:   ocspRequest(0x11, caCert),
+			expStatus: ocsp.Good,
+		},
+		"good status for a certificate recorded in the issuer status": {
+			path:      issuerPath + "ca",
+			request:   ocspRequest(0x0F, caCert),
+			expStatus: ocsp.Good,
+		},
+		"good status using a GET request": {
+			path:      issuerPath + "ca",
+			get:       true,
+			request:   ocspRequest(0x11, caCert),
+			expStatus: ocsp.Good,
+		},
+		"revoked status for a revoked certificate": {
+			path:      issuerPath + "ca",
+			request:   ocspRequest(0x0A, caCert),
+			expStatus: ocsp.Revoked,
+		},
+		"unknown status for a certificate that has not been issued": {
+			path:      issuerPath + "ca",
+			request:   ocspRequest(0x0E, caCert),
+			expStatus: ocsp.Unknown,
+		},
+		"unknown status for a certificate issued by a different issuer": {
+			path:      issuerPath + "ca",
+			request:   ocspRequest(0x0B, caCert),
+			expStatus: ocsp.Unknown,
+		},
+		"good status for a ClusterIssuer": {
+			path:      "/clusterissuers/ca",
+			request:   ocspRequest(0x0B, caCert),
+			expStatus: ocsp.Good,
+		},
+		"response signed by a delegated responder": {
+			path:             issuerPath + "delegated",
+			request:          ocspRequest(0x0C, caCert),
+			expStatus:        ocsp.Good,
+			expResponderCert: responderCert,
+		},
+		"internal error if the delegated responder does not have the ocsp signing usage": {
+			path:     issuerPath + "invalid-delegated",
+			request:  ocspRequest(0x0C, caCert),
+			expError: ocsp.InternalError,
+		},
+		"unauthorized if the request is for a different CA": {
+			path:     issuerPath + "ca",
+			request:  ocspRequest(0x0A, otherCACert),
+			expError: ocsp.Unauthorized,
+		},
+		"unauthorized if the issuer does not exist": {
+			path:     issuerPath + "missing",
+			request:  ocspRequest(0x0A, caCert),
+			expError: ocsp.Unauthorized,
+		},
+		"unauthorized if the issuer is not a CA issuer": {
+			path:     issuerPath + "self-signed",
+			request:  ocspRequest(0x0A, caCert),
+			expError: ocsp.Unauthorized,
+		},
+		"malformed request": {
+			path:     issuerPath + "ca",
+			request:  []byte("not a request"),
+			expError: ocsp.Malformed,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := New(context.Background(), Options{
+				ClusterResourceNamespace: "cluster-resources",
+				ResponseValidity:         time.Hour,
+			}, issuerLister, clusterIssuerLister, secretLister, certificateIndexer, certificateRequestIndexer)
+			r.clock = fakeclock.NewFakeClock(now)
+
+			var req *http.Request
+			if test.get {
+				req = httptest.NewRequest(http.MethodGet, test.path+"/"+url.PathEscape(base64.StdEncoding.EncodeToString(test.request)), nil)
+			} else {
+				req = httptest.NewRequest(http.MethodPost, test.path, bytes.NewReader(test.request))
+				req.Header.Set("Content-Type", contentTypeOCSPRequest)
+			}
+			rec := httptest.NewRecorder()
+			r.Handler.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status code %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != contentTypeOCSPResponse {
+				t.Errorf("expected content type %q, got %q", contentTypeOCSPResponse, ct)
+			}
+
+			resp, err := ocsp.ParseResponse(rec.Body.Bytes(), caCert)
+			if test.expError != ocsp.Success {
+				respErr, ok := err.(ocsp.ResponseError)
+				if !ok || respErr.Status != test.expError {
+					t.Fatalf("expected response error %v, got: %v", test.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error parsing response: %v", err)
+			}
+
+			if resp.Status != test.expStatus {
+				t.Errorf("expected status %d, got %d", test.expStatus, resp.Status)
+			}
+			if !resp.NextUpdate.Equal(now.Add(time.Hour)) {
+				t.Errorf("expected next update %v, got %v", now.Add(time.Hour), resp.NextUpdate)
+			}
+			if resp.Status == ocsp.Revoked && !resp.RevokedAt.Equal(now.Add(-time.Minute)) {
+				t.Errorf("expected revocation time %v, got %v", now.Add(-time.Minute), resp.RevokedAt)
+			}
+			if test.expResponderCert == nil {
+				if resp.Certificate != nil {
+					t.Errorf("expected response to be signed by the CA")
+				}
+			} else if resp.Certificate == nil || !resp.Certificate.Equal(test.expResponderCert) {
+				t.Errorf("expected response to be signed by the delegated responder")
+			}
+		})
+	}
+}
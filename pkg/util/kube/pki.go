@@ -22,8 +22,12 @@ import (
 	"crypto/x509"
 
 	api "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
 
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/util/errors"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
@@ -66,6 +70,23 @@ func SecretTLSKey(ctx context.Context, secretLister corelisters.SecretLister, na
 	return SecretTLSKeyRef(ctx, secretLister, namespace, name, api.TLSPrivateKeyKey)
 }
 
+// SecretTLSKeyForCertificate will decode the private key that should be used
+// when issuing the given Certificate. If the Certificate's private key is
+// being rotated, the pending private key stored in the Secret named by
+// apiutil.NextPrivateKeySecretName is returned. Otherwise, the private key
+// stored in the Certificate's target Secret is returned.
+func SecretTLSKeyForCertificate(ctx context.Context, secretLister corelisters.SecretLister, crt *cmapi.Certificate) (crypto.Signer, error) {
+	nextKeySecret, err := secretLister.Secrets(crt.Namespace).Get(apiutil.NextPrivateKeySecretName(crt))
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return nil, err
+	}
+	if nextKeySecret != nil && metav1.IsControlledBy(nextKeySecret, crt) {
+		return SecretTLSKey(ctx, secretLister, nextKeySecret.Namespace, nextKeySecret.Name)
+	}
+
+	return SecretTLSKey(ctx, secretLister, crt.Namespace, crt.Spec.SecretName)
+}
+
 func SecretTLSCertChain(ctx context.Context, secretLister corelisters.SecretLister, namespace, name string) ([]*x509.Certificate, error) {
 	log := logf.FromContext(ctx)
 	log = logf.WithRelatedResourceName(log, name, namespace, "Secret")
@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"net/url"
 	"time"
 
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
@@ -78,6 +79,31 @@ func IPAddressesToString(ipAddresses []net.IP) []string {
 	return ipNames
 }
 
+// URIsForCertificate returns the URI subject alternative names that should be
+// used for the given Certificate resource, by parsing the URISANs field.
+func URIsForCertificate(crt *v1alpha1.Certificate) ([]*url.URL, error) {
+	var uris []*url.URL
+	for _, uriName := range crt.Spec.URISANs {
+		uri, err := url.Parse(uriName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse uri SAN %q: %s", uriName, err.Error())
+		}
+		uris = append(uris, uri)
+	}
+	return uris, nil
+}
+
+func URLsToString(uris []*url.URL) []string {
+	var uriNames []string
+	for _, uri := range uris {
+		if uri == nil {
+			continue
+		}
+		uriNames = append(uriNames, uri.String())
+	}
+	return uriNames
+}
+
 func removeDuplicates(in []string) []string {
 	var found []string
 Outer:
@@ -105,6 +131,27 @@ func OrganizationForCertificate(crt *v1alpha1.Certificate) []string {
 	return crt.Spec.Organization
 }
 
+// SubjectForCertificate will return the full X.509 subject to set for the
+// Certificate resource, including the common name and organization.
+func SubjectForCertificate(crt *v1alpha1.Certificate) pkix.Name {
+	subject := pkix.Name{
+		Organization: OrganizationForCertificate(crt),
+		CommonName:   CommonNameForCertificate(crt),
+	}
+
+	if s := crt.Spec.Subject; s != nil {
+		subject.Country = s.Countries
+		subject.OrganizationalUnit = s.OrganizationalUnits
+		subject.Locality = s.Localities
+		subject.Province = s.Provinces
+		subject.StreetAddress = s.StreetAddresses
+		subject.PostalCode = s.PostalCodes
+		subject.SerialNumber = s.SerialNumber
+	}
+
+	return subject
+}
+
 var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
 
 // GenerateCSR will generate a new *x509.CertificateRequest template to be used
@@ -115,9 +162,13 @@ func GenerateCSR(issuer v1alpha1.GenericIssuer, crt *v1alpha1.Certificate) (*x50
 	commonName := CommonNameForCertificate(crt)
 	dnsNames := DNSNamesForCertificate(crt)
 	iPAddresses := IPAddressesForCertificate(crt)
-	organization := OrganizationForCertificate(crt)
 
-	if len(commonName) == 0 && len(dnsNames) == 0 {
+	uris, err := URIsForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(commonName) == 0 && len(dnsNames) == 0 && len(uris) == 0 && len(crt.Spec.EmailSANs) == 0 {
 		return nil, fmt.Errorf("no domains specified on certificate")
 	}
 
@@ -130,12 +181,11 @@ func GenerateCSR(issuer v1alpha1.GenericIssuer, crt *v1alpha1.Certificate) (*x50
 		Version:            3,
 		SignatureAlgorithm: sigAlgo,
 		PublicKeyAlgorithm: pubKeyAlgo,
-		Subject: pkix.Name{
-			Organization: organization,
-			CommonName:   commonName,
-		},
-		DNSNames:    dnsNames,
-		IPAddresses: iPAddresses,
+		Subject:            SubjectForCertificate(crt),
+		DNSNames:           dnsNames,
+		IPAddresses:        iPAddresses,
+		URIs:               uris,
+		EmailAddresses:     crt.Spec.EmailSANs,
 		// TODO: work out how best to handle extensions/key usages here
 		ExtraExtensions: []pkix.Extension{},
 	}, nil
@@ -149,9 +199,13 @@ func GenerateTemplate(crt *v1alpha1.Certificate) (*x509.Certificate, error) {
 	commonName := CommonNameForCertificate(crt)
 	dnsNames := DNSNamesForCertificate(crt)
 	ipAddresses := IPAddressesForCertificate(crt)
-	organization := OrganizationForCertificate(crt)
 
-	if len(commonName) == 0 && len(dnsNames) == 0 {
+	uris, err := URIsForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(commonName) == 0 && len(dnsNames) == 0 && len(uris) == 0 && len(crt.Spec.EmailSANs) == 0 {
 		return nil, fmt.Errorf("no domains specified on certificate")
 	}
 
@@ -170,28 +224,142 @@ func GenerateTemplate(crt *v1alpha1.Certificate) (*x509.Certificate, error) {
 		return nil, err
 	}
 
-	keyUsages := x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
-	if crt.Spec.IsCA {
-		keyUsages |= x509.KeyUsageCertSign
+	keyUsages, extKeyUsages, err := BuildKeyUsages(crt.Spec.Usages, crt.Spec.IsCA)
+	if err != nil {
+		return nil, err
 	}
 
-	return &x509.Certificate{
+	template := &x509.Certificate{
 		Version:               3,
 		BasicConstraintsValid: true,
 		SerialNumber:          serialNumber,
 		PublicKeyAlgorithm:    pubKeyAlgo,
 		IsCA:                  crt.Spec.IsCA,
-		Subject: pkix.Name{
-			Organization: organization,
-			CommonName:   commonName,
-		},
-		NotBefore: time.Now(),
-		NotAfter:  time.Now().Add(certDuration),
+		Subject:               SubjectForCertificate(crt),
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(certDuration),
 		// see http://golang.org/pkg/crypto/x509/#KeyUsage
-		KeyUsage:    keyUsages,
-		DNSNames:    dnsNames,
-		IPAddresses: ipAddresses,
-	}, nil
+		KeyUsage:       keyUsages,
+		ExtKeyUsage:    extKeyUsages,
+		DNSNames:       dnsNames,
+		IPAddresses:    ipAddresses,
+		URIs:           uris,
+		EmailAddresses: crt.Spec.EmailSANs,
+	}
+
+	if err := setCAConstraints(template, crt.Spec.MaxPathLen, crt.Spec.NameConstraints); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// GenerateTemplateFromCertificateRequest will create an x509.Certificate for
+// the given CertificateRequest resource. The subject, subject alternative
+// names and public key are all taken from the PEM encoded CSR contained in the
+// resource, after its signature has been verified.
+func GenerateTemplateFromCertificateRequest(cr *v1alpha1.CertificateRequest) (*x509.Certificate, error) {
+	csr, err := DecodeX509CertificateRequestBytes(cr.Spec.CSRPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := csr.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("failed to verify certificate request signature: %s", err.Error())
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %s", err.Error())
+	}
+
+	certDuration := v1alpha1.DefaultCertificateDuration
+	if cr.Spec.Duration != nil {
+		certDuration = cr.Spec.Duration.Duration
+	}
+
+	keyUsages, extKeyUsages, err := BuildKeyUsages(cr.Spec.Usages, cr.Spec.IsCA)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		Version:               3,
+		BasicConstraintsValid: true,
+		SerialNumber:          serialNumber,
+		PublicKeyAlgorithm:    csr.PublicKeyAlgorithm,
+		PublicKey:             csr.PublicKey,
+		IsCA:                  cr.Spec.IsCA,
+		Subject:               csr.Subject,
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(certDuration),
+		// see http://golang.org/pkg/crypto/x509/#KeyUsage
+		KeyUsage:       keyUsages,
+		ExtKeyUsage:    extKeyUsages,
+		DNSNames:       csr.DNSNames,
+		IPAddresses:    csr.IPAddresses,
+		URIs:           csr.URIs,
+		EmailAddresses: csr.EmailAddresses,
+	}
+
+	if err := setCAConstraints(template, cr.Spec.MaxPathLen, cr.Spec.NameConstraints); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// setCAConstraints sets the path length and name constraints of a CA
+// certificate template. It is a no-op if the template is not for a CA.
+func setCAConstraints(template *x509.Certificate, maxPathLen *int32, nc *v1alpha1.NameConstraints) error {
+	if !template.IsCA {
+		return nil
+	}
+
+	if maxPathLen != nil {
+		template.MaxPathLen = int(*maxPathLen)
+		template.MaxPathLenZero = *maxPathLen == 0
+	}
+
+	if nc == nil {
+		return nil
+	}
+
+	template.PermittedDNSDomainsCritical = nc.Critical
+	if nc.Permitted != nil {
+		ipRanges, err := parseIPRanges(nc.Permitted.IPRanges)
+		if err != nil {
+			return err
+		}
+		template.PermittedDNSDomains = nc.Permitted.DNSDomains
+		template.PermittedIPRanges = ipRanges
+		template.PermittedEmailAddresses = nc.Permitted.EmailAddresses
+		template.PermittedURIDomains = nc.Permitted.URIDomains
+	}
+	if nc.Excluded != nil {
+		ipRanges, err := parseIPRanges(nc.Excluded.IPRanges)
+		if err != nil {
+			return err
+		}
+		template.ExcludedDNSDomains = nc.Excluded.DNSDomains
+		template.ExcludedIPRanges = ipRanges
+		template.ExcludedEmailAddresses = nc.Excluded.EmailAddresses
+		template.ExcludedURIDomains = nc.Excluded.URIDomains
+	}
+
+	return nil
+}
+
+func parseIPRanges(cidrs []string) ([]*net.IPNet, error) {
+	var ipRanges []*net.IPNet
+	for _, c := range cidrs {
+		_, ipNet, err := net.ParseCIDR(c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse name constraint IP range %q: %v", c, err)
+		}
+		ipRanges = append(ipRanges, ipNet)
+	}
+	return ipRanges, nil
 }
 
 // SignCertificate returns a signed x509.Certificate object for the given
@@ -300,8 +468,11 @@ func SignatureAlgorithm(crt *v1alpha1.Certificate) (x509.PublicKeyAlgorithm, x50
 		default:
 			return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported ecdsa keysize specified: %d", crt.Spec.KeySize)
 		}
+	case v1alpha1.Ed25519KeyAlgorithm:
+		pubKeyAlgo = x509.Ed25519
+		sigAlgo = x509.PureEd25519
 	default:
-		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported algorithm specified: %s. should be either 'ecdsa' or 'rsa", crt.Spec.KeyAlgorithm)
+		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported algorithm specified: %s. should be one of 'ecdsa', 'rsa' or 'ed25519'", crt.Spec.KeyAlgorithm)
 	}
 	return pubKeyAlgo, sigAlgo, nil
 }
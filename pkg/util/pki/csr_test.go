@@ -18,6 +18,8 @@ package pki
 
 import (
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"reflect"
 	"testing"
 
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
@@ -222,6 +224,12 @@ func TestSignatureAlgorithmForCertificate(t *testing.T) {
 			keySize:   100,
 			expectErr: true,
 		},
+		{
+			name:            "certificate with KeyAlgorithm ed25519",
+			keyAlgo:         v1alpha1.Ed25519KeyAlgorithm,
+			expectedSigAlgo: x509.PureEd25519,
+			expectedKeyType: x509.Ed25519,
+		},
 		{
 			name:      "certificate with KeyAlgorithm set to unknown key algo",
 			keyAlgo:   v1alpha1.KeyAlgorithm("blah"),
@@ -293,3 +301,187 @@ func TestRemoveDuplicates(t *testing.T) {
 		}
 	}
 }
+
+func TestURIsForCertificate(t *testing.T) {
+	type testT struct {
+		name         string
+		crtURISANs   []string
+		expectedURIs []string
+		expectErr    bool
+	}
+	tests := []testT{
+		{
+			name:         "certificate with no uri SANs",
+			expectedURIs: nil,
+		},
+		{
+			name:         "certificate with a spiffe uri SAN",
+			crtURISANs:   []string{"spiffe://cluster.local/ns/sandbox/sa/foo"},
+			expectedURIs: []string{"spiffe://cluster.local/ns/sandbox/sa/foo"},
+		},
+		{
+			name:         "certificate with multiple uri SANs",
+			crtURISANs:   []string{"spiffe://cluster.local/ns/sandbox/sa/foo", "https://example.com/foo"},
+			expectedURIs: []string{"spiffe://cluster.local/ns/sandbox/sa/foo", "https://example.com/foo"},
+		},
+		{
+			name:       "certificate with an invalid uri SAN",
+			crtURISANs: []string{"spiffe://%zz"},
+			expectErr:  true,
+		},
+	}
+	testFn := func(test testT) func(*testing.T) {
+		return func(t *testing.T) {
+			actualURIs, err := URIsForCertificate(&v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{URISANs: test.crtURISANs},
+			})
+			if err != nil && !test.expectErr {
+				t.Errorf("expected no error but got: %v", err)
+				return
+			}
+			if err == nil && test.expectErr {
+				t.Errorf("expected an error but got none")
+				return
+			}
+			if !util.EqualUnsorted(URLsToString(actualURIs), test.expectedURIs) {
+				t.Errorf("expected %q but got %q", test.expectedURIs, URLsToString(actualURIs))
+				return
+			}
+		}
+	}
+	for _, test := range tests {
+		t.Run(test.name, testFn(test))
+	}
+}
+
+func TestSubjectForCertificate(t *testing.T) {
+	crt := buildCertificate("cn")
+	crt.Spec.Organization = []string{"org"}
+	crt.Spec.Subject = &v1alpha1.X509Subject{
+		Countries:           []string{"GB"},
+		OrganizationalUnits: []string{"ou"},
+		Localities:          []string{"London"},
+		Provinces:           []string{"Greater London"},
+		StreetAddresses:     []string{"1 Street"},
+		PostalCodes:         []string{"SW1A 1AA"},
+		SerialNumber:        "1234",
+	}
+
+	subject := SubjectForCertificate(crt)
+	expected := pkix.Name{
+		CommonName:         "cn",
+		Organization:       []string{"org"},
+		Country:            []string{"GB"},
+		OrganizationalUnit: []string{"ou"},
+		Locality:           []string{"London"},
+		Province:           []string{"Greater London"},
+		StreetAddress:      []string{"1 Street"},
+		PostalCode:         []string{"SW1A 1AA"},
+		SerialNumber:       "1234",
+	}
+	if !reflect.DeepEqual(subject, expected) {
+		t.Errorf("expected %v but got %v", expected, subject)
+	}
+
+	subject = SubjectForCertificate(buildCertificate("cn"))
+	expected = pkix.Name{
+		CommonName:   "cn",
+		Organization: []string{defaultOrganization},
+	}
+	if !reflect.DeepEqual(subject, expected) {
+		t.Errorf("expected %v but got %v", expected, subject)
+	}
+}
+
+func TestGenerateTemplateSANs(t *testing.T) {
+	crt := buildCertificate("", "example.com")
+	crt.Spec.IPAddresses = []string{"127.0.0.1"}
+	crt.Spec.URISANs = []string{"spiffe://cluster.local/ns/sandbox/sa/foo"}
+	crt.Spec.EmailSANs = []string{"alice@example.com"}
+
+	template, err := GenerateTemplate(crt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !util.EqualUnsorted(template.DNSNames, []string{"example.com"}) {
+		t.Errorf("unexpected DNS names: %q", template.DNSNames)
+	}
+	if !util.EqualUnsorted(IPAddressesToString(template.IPAddresses), crt.Spec.IPAddresses) {
+		t.Errorf("unexpected IP addresses: %q", IPAddressesToString(template.IPAddresses))
+	}
+	if !util.EqualUnsorted(URLsToString(template.URIs), crt.Spec.URISANs) {
+		t.Errorf("unexpected URI SANs: %q", URLsToString(template.URIs))
+	}
+	if !util.EqualUnsorted(template.EmailAddresses, crt.Spec.EmailSANs) {
+		t.Errorf("unexpected email SANs: %q", template.EmailAddresses)
+	}
+
+	crt = buildCertificate("")
+	crt.Spec.EmailSANs = []string{"alice@example.com"}
+	if _, err := GenerateTemplate(crt); err != nil {
+		t.Errorf("expected a certificate with only email SANs to be valid, but got: %v", err)
+	}
+}
+
+func TestGenerateTemplateCAConstraints(t *testing.T) {
+	maxPathLen := int32(0)
+	crt := buildCertificate("intermediate")
+	crt.Spec.IsCA = true
+	crt.Spec.MaxPathLen = &maxPathLen
+	crt.Spec.NameConstraints = &v1alpha1.NameConstraints{
+		Critical: true,
+		Permitted: &v1alpha1.NameConstraintItem{
+			DNSDomains:     []string{"team-a.example.com"},
+			IPRanges:       []string{"10.0.0.0/8"},
+			EmailAddresses: []string{"team-a.example.com"},
+			URIDomains:     []string{".team-a.example.com"},
+		},
+		Excluded: &v1alpha1.NameConstraintItem{
+			DNSDomains: []string{"secret.team-a.example.com"},
+		},
+	}
+
+	template, err := GenerateTemplate(crt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatalf("unexpected error generating private key: %v", err)
+	}
+	_, cert, err := SignCertificate(template, template, pk.Public(), pk)
+	if err != nil {
+		t.Fatalf("unexpected error signing certificate: %v", err)
+	}
+
+	if cert.MaxPathLen != 0 || !cert.MaxPathLenZero {
+		t.Errorf("expected a max path length of zero, got %d (MaxPathLenZero=%t)", cert.MaxPathLen, cert.MaxPathLenZero)
+	}
+	if !cert.PermittedDNSDomainsCritical {
+		t.Errorf("expected name constraints to be critical")
+	}
+	if !reflect.DeepEqual(cert.PermittedDNSDomains, []string{"team-a.example.com"}) {
+		t.Errorf("unexpected permitted DNS domains: %q", cert.PermittedDNSDomains)
+	}
+	if len(cert.PermittedIPRanges) != 1 || cert.PermittedIPRanges[0].String() != "10.0.0.0/8" {
+		t.Errorf("unexpected permitted IP ranges: %v", cert.PermittedIPRanges)
+	}
+	if !reflect.DeepEqual(cert.PermittedEmailAddresses, []string{"team-a.example.com"}) {
+		t.Errorf("unexpected permitted email addresses: %q", cert.PermittedEmailAddresses)
+	}
+	if !reflect.DeepEqual(cert.PermittedURIDomains, []string{".team-a.example.com"}) {
+		t.Errorf("unexpected permitted URI domains: %q", cert.PermittedURIDomains)
+	}
+	if !reflect.DeepEqual(cert.ExcludedDNSDomains, []string{"secret.team-a.example.com"}) {
+		t.Errorf("unexpected excluded DNS domains: %q", cert.ExcludedDNSDomains)
+	}
+
+	crt.Spec.IsCA = false
+	template, err = GenerateTemplate(crt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if template.MaxPathLenZero || len(template.PermittedDNSDomains) != 0 {
+		t.Errorf("expected constraints not to be set on a certificate that is not a CA")
+	}
+}
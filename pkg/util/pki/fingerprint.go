@@ -0,0 +1,48 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"strings"
+)
+
+// SerialNumberString returns the serial number of the given certificate
+// encoded as upper-case hex, in the same format as printed by
+// `openssl x509 -serial`.
+func SerialNumberString(cert *x509.Certificate) string {
+	s := fmt.Sprintf("%X", cert.SerialNumber)
+	// openssl always prints a whole number of bytes
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	return s
+}
+
+// SHA256Fingerprint returns the SHA-256 fingerprint of the DER encoded
+// certificate, formatted as colon separated upper-case hex bytes in the same
+// format as printed by `openssl x509 -fingerprint -sha256`.
+func SHA256Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	hexBytes := make([]string, len(sum))
+	for i, b := range sum {
+		hexBytes[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(hexBytes, ":")
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"testing"
+)
+
+// testFingerprintCert was generated by openssl with serial 0x0a1b2c
+const testFingerprintCert = `-----BEGIN CERTIFICATE-----
+MIIBYTCCAQigAwIBAgIDChssMAoGCCqGSM49BAMCMA8xDTALBgNVBAMMBHRlc3Qw
+HhcNMjYxMDE2MTQ0NDUwWhcNMjYxMDE3MTQ0NDUwWjAPMQ0wCwYDVQQDDAR0ZXN0
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE5H+xDwnGc4T7c79UkZFvpObaAcXo
+IlA20xErzWe6q0fdetEwojdUMOME8PC5fxpcU+goCwfuF70JUcOfQ9+CMqNTMFEw
+HQYDVR0OBBYEFBLr3jzdRe9UZFOHOk5okxG7fG+1MB8GA1UdIwQYMBaAFBLr3jzd
+Re9UZFOHOk5okxG7fG+1MA8GA1UdEwEB/wQFMAMBAf8wCgYIKoZIzj0EAwIDRwAw
+RAIgafsATNFIrYqys+QA1l3lqmmEsMyT37lgsKaweRTDN1MCIDTt2o8+CkWP5gQi
+1T/5DV6MCHJO++Y7hqK+EAm2n1bG
+-----END CERTIFICATE-----
+`
+
+func TestSerialNumberAndFingerprint(t *testing.T) {
+	cert, err := DecodeX509CertificateBytes([]byte(testFingerprintCert))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// expected values are as printed by
+	// `openssl x509 -noout -serial -fingerprint -sha256`
+	if s := SerialNumberString(cert); s != "0A1B2C" {
+		t.Errorf("expected serial number %q but got %q", "0A1B2C", s)
+	}
+	expectedFingerprint := "72:40:2F:C1:91:F9:C8:E2:BC:A4:FE:57:96:00:DE:D5:A9:14:0E:F8:1C:00:04:35:D1:E0:53:A9:23:6B:D9:3B"
+	if f := SHA256Fingerprint(cert); f != expectedFingerprint {
+		t.Errorf("expected fingerprint %q but got %q", expectedFingerprint, f)
+	}
+}
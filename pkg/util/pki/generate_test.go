@@ -19,11 +19,13 @@ package pki
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
 	"strings"
 	"testing"
@@ -124,6 +126,11 @@ func TestGeneratePrivateKeyForCertificate(t *testing.T) {
 			keySize:   521,
 			expectErr: false,
 		},
+		{
+			name:      "ed25519 key",
+			keyAlgo:   v1alpha1.Ed25519KeyAlgorithm,
+			expectErr: false,
+		},
 		{
 			name:      "valid key size with key algorithm not specified",
 			keyAlgo:   v1alpha1.KeyAlgorithm(""),
@@ -213,6 +220,13 @@ func TestGeneratePrivateKeyForCertificate(t *testing.T) {
 						return
 					}
 				}
+
+				if test.keyAlgo == "ed25519" {
+					if _, ok := privateKey.(ed25519.PrivateKey); !ok {
+						t.Errorf("expected ed25519 private key, but got %T", privateKey)
+						return
+					}
+				}
 			}
 		}
 	}
@@ -335,3 +349,141 @@ func TestPublicKeyMatchesCertificateRequest(t *testing.T) {
 		t.Errorf("expected private key to not match certificate, but it did")
 	}
 }
+
+func TestPublicKeyMatchesCertificateEd25519(t *testing.T) {
+	privKey1, err := GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Errorf("error generating private key: %v", err)
+	}
+	privKey2, err := GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Errorf("error generating private key: %v", err)
+	}
+
+	template, err := GenerateTemplate(buildCertificateWithKeyParams(v1alpha1.Ed25519KeyAlgorithm, 0))
+	if err != nil {
+		t.Fatalf("error generating template: %v", err)
+	}
+	_, testCert1, err := SignCertificate(template, template, privKey1.Public(), privKey1)
+	if err != nil {
+		t.Fatalf("error signing test cert: %v", err)
+	}
+
+	matches, err := PublicKeyMatchesCertificate(privKey1.Public(), testCert1)
+	if err != nil {
+		t.Errorf("expected no error, but got: %v", err)
+	}
+	if !matches {
+		t.Errorf("expected private key to match certificate, but it did not")
+	}
+
+	matches, err = PublicKeyMatchesCertificate(privKey2.Public(), testCert1)
+	if err != nil {
+		t.Errorf("expected no error, but got: %v", err)
+	}
+	if matches {
+		t.Errorf("expected private key to not match certificate, but it did")
+	}
+}
+
+func TestPublicKeysEqual(t *testing.T) {
+	rsaKey1, err := GenerateRSAPrivateKey(MinRSAKeySize)
+	if err != nil {
+		t.Fatalf("error generating rsa private key: %v", err)
+	}
+	rsaKey2, err := GenerateRSAPrivateKey(MinRSAKeySize)
+	if err != nil {
+		t.Fatalf("error generating rsa private key: %v", err)
+	}
+	ecKey, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatalf("error generating ecdsa private key: %v", err)
+	}
+
+	tests := map[string]struct {
+		a, b     crypto.PublicKey
+		expected bool
+	}{
+		"same rsa key":      {a: rsaKey1.Public(), b: rsaKey1.Public(), expected: true},
+		"different rsa key": {a: rsaKey1.Public(), b: rsaKey2.Public(), expected: false},
+		"same ecdsa key":    {a: ecKey.Public(), b: ecKey.Public(), expected: true},
+		"different types":   {a: rsaKey1.Public(), b: ecKey.Public(), expected: false},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			equal, err := PublicKeysEqual(test.a, test.b)
+			if err != nil {
+				t.Fatalf("expected no error, but got: %v", err)
+			}
+			if equal != test.expected {
+				t.Errorf("expected %t but got %t", test.expected, equal)
+			}
+		})
+	}
+}
+
+func TestEncodePrivateKeyWithEncoding(t *testing.T) {
+	rsaKey, err := GenerateRSAPrivateKey(MinRSAKeySize)
+	if err != nil {
+		t.Fatalf("error generating rsa private key: %v", err)
+	}
+	ecKey, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatalf("error generating ecdsa private key: %v", err)
+	}
+	edKey, err := GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Fatalf("error generating ed25519 private key: %v", err)
+	}
+
+	type testT struct {
+		name          string
+		key           crypto.PrivateKey
+		encoding      v1alpha1.KeyEncoding
+		expectedBlock string
+		expectErr     bool
+	}
+
+	tests := []testT{
+		{name: "rsa with no encoding", key: rsaKey, expectedBlock: "RSA PRIVATE KEY"},
+		{name: "rsa with pkcs1 encoding", key: rsaKey, encoding: v1alpha1.PKCS1, expectedBlock: "RSA PRIVATE KEY"},
+		{name: "rsa with pkcs8 encoding", key: rsaKey, encoding: v1alpha1.PKCS8, expectedBlock: "PRIVATE KEY"},
+		{name: "ecdsa with pkcs1 encoding", key: ecKey, encoding: v1alpha1.PKCS1, expectedBlock: "EC PRIVATE KEY"},
+		{name: "ecdsa with pkcs8 encoding", key: ecKey, encoding: v1alpha1.PKCS8, expectedBlock: "PRIVATE KEY"},
+		{name: "ed25519 with pkcs1 encoding", key: edKey, encoding: v1alpha1.PKCS1, expectedBlock: "PRIVATE KEY"},
+		{name: "ed25519 with pkcs8 encoding", key: edKey, encoding: v1alpha1.PKCS8, expectedBlock: "PRIVATE KEY"},
+		{name: "unknown encoding", key: rsaKey, encoding: v1alpha1.KeyEncoding("blah"), expectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keyPEM, err := EncodePrivateKeyWithEncoding(test.key, test.encoding)
+			if test.expectErr {
+				if err == nil {
+					t.Errorf("expected error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			block, _ := pem.Decode(keyPEM)
+			if block == nil {
+				t.Fatalf("failed to decode encoded private key")
+			}
+			if block.Type != test.expectedBlock {
+				t.Errorf("expected pem block type %q, got %q", test.expectedBlock, block.Type)
+			}
+
+			decoded, err := DecodePrivateKeyBytes(keyPEM)
+			if err != nil {
+				t.Fatalf("error decoding encoded private key: %v", err)
+			}
+			matches, err := PublicKeyMatchesCertificate(decoded.Public(), &x509.Certificate{PublicKey: test.key.(crypto.Signer).Public()})
+			if err != nil || !matches {
+				t.Errorf("decoded private key does not match original private key")
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/pavel-v-chernykh/keystore-go"
+)
+
+const (
+	// JKSPrivateKeyAlias is the alias of the private key entry written to
+	// JKS keystores.
+	JKSPrivateKeyAlias = "certificate"
+	// JKSTrustedCertAlias is the alias prefix of trusted certificate entries
+	// written to JKS truststores.
+	JKSTrustedCertAlias = "ca"
+)
+
+// EncodeJKSKeystore will encode the given private key and certificate chain
+// into a JKS keystore, protected using the given password.
+// The first certificate in the chain must correspond to the private key.
+func EncodeJKSKeystore(password string, key crypto.PrivateKey, chain []*x509.Certificate) ([]byte, error) {
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("error encoding JKS keystore: no certificates provided")
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding private key: %v", err)
+	}
+
+	ks := keystore.KeyStore{
+		JKSPrivateKeyAlias: &keystore.PrivateKeyEntry{
+			Entry:     keystore.Entry{CreationDate: time.Now()},
+			PrivKey:   keyDER,
+			CertChain: jksCertificates(chain),
+		},
+	}
+	return encodeJKS(password, ks)
+}
+
+// EncodeJKSTruststore will encode the given certificates as trusted
+// certificate entries into a JKS keystore, protected using the given
+// password.
+func EncodeJKSTruststore(password string, certs []*x509.Certificate) ([]byte, error) {
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("error encoding JKS truststore: no certificates provided")
+	}
+
+	ks := keystore.KeyStore{}
+	for i, cert := range jksCertificates(certs) {
+		alias := JKSTrustedCertAlias
+		if i > 0 {
+			alias = fmt.Sprintf("%s-%d", JKSTrustedCertAlias, i)
+		}
+		ks[alias] = &keystore.TrustedCertificateEntry{
+			Entry:       keystore.Entry{CreationDate: time.Now()},
+			Certificate: cert,
+		}
+	}
+	return encodeJKS(password, ks)
+}
+
+// PasswordMatchesJKSKeystore returns true if the given password is the
+// password used to protect the given JKS keystore or truststore.
+func PasswordMatchesJKSKeystore(password string, data []byte) (bool, error) {
+	_, err := keystore.Decode(bytes.NewReader(data), []byte(password))
+	switch err {
+	case nil:
+		return true, nil
+	case keystore.ErrInvalidDigest, keystore.ErrUnrecoverablePrivateKey:
+		return false, nil
+	default:
+		return false, fmt.Errorf("error decoding JKS keystore: %v", err)
+	}
+}
+
+func encodeJKS(password string, ks keystore.KeyStore) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := keystore.Encode(&buf, ks, []byte(password)); err != nil {
+		return nil, fmt.Errorf("error encoding JKS keystore: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func jksCertificates(certs []*x509.Certificate) []keystore.Certificate {
+	var out []keystore.Certificate
+	for _, cert := range certs {
+		out = append(out, keystore.Certificate{
+			Type:    "X.509",
+			Content: cert.Raw,
+		})
+	}
+	return out
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"testing"
+
+	"github.com/pavel-v-chernykh/keystore-go"
+)
+
+// decodeJKS decodes the given JKS keystore, verifying its integrity using the
+// given password.
+func decodeJKS(t *testing.T, data []byte, password string) keystore.KeyStore {
+	ks, err := keystore.Decode(bytes.NewReader(data), []byte(password))
+	if err != nil {
+		t.Fatalf("error decoding keystore: %v", err)
+	}
+	return ks
+}
+
+func TestEncodeJKSKeystore(t *testing.T) {
+	key, chain := generateTestChain(t)
+
+	data, err := EncodeJKSKeystore("pässword", key, chain)
+	if err != nil {
+		t.Fatalf("unexpected error encoding keystore: %v", err)
+	}
+
+	ks := decodeJKS(t, data, "pässword")
+	if len(ks) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(ks))
+	}
+	e, ok := ks[JKSPrivateKeyAlias].(*keystore.PrivateKeyEntry)
+	if !ok {
+		t.Fatalf("expected private key entry with alias %q, got %v", JKSPrivateKeyAlias, ks)
+	}
+	if len(e.CertChain) != len(chain) {
+		t.Fatalf("expected %d certificates, got %d", len(chain), len(e.CertChain))
+	}
+	for i := range chain {
+		if !bytes.Equal(chain[i].Raw, e.CertChain[i].Content) {
+			t.Errorf("certificate %d does not match", i)
+		}
+	}
+	decodedKey, err := x509.ParsePKCS8PrivateKey(e.PrivKey)
+	if err != nil {
+		t.Fatalf("error parsing private key: %v", err)
+	}
+	matches, err := PublicKeyMatchesCertificate(decodedKey.(crypto.Signer).Public(), chain[0])
+	if err != nil || !matches {
+		t.Errorf("decoded private key does not match certificate")
+	}
+
+	if _, err := EncodeJKSKeystore("password", key, nil); err == nil {
+		t.Errorf("expected error encoding keystore with no certificates")
+	}
+}
+
+func TestEncodeJKSTruststore(t *testing.T) {
+	_, chain := generateTestChain(t)
+
+	data, err := EncodeJKSTruststore("password", chain)
+	if err != nil {
+		t.Fatalf("unexpected error encoding truststore: %v", err)
+	}
+
+	ks := decodeJKS(t, data, "password")
+	if len(ks) != len(chain) {
+		t.Fatalf("expected %d entries, got %d", len(chain), len(ks))
+	}
+	for i, alias := range []string{"ca", "ca-1"} {
+		e, ok := ks[alias].(*keystore.TrustedCertificateEntry)
+		if !ok {
+			t.Errorf("expected trusted certificate entry with alias %q", alias)
+			continue
+		}
+		if !bytes.Equal(chain[i].Raw, e.Certificate.Content) {
+			t.Errorf("trusted certificate %d does not match", i)
+		}
+	}
+
+	if _, err := EncodeJKSTruststore("password", nil); err == nil {
+		t.Errorf("expected error encoding truststore with no certificates")
+	}
+}
+
+func TestPasswordMatchesJKSKeystore(t *testing.T) {
+	key, chain := generateTestChain(t)
+	keystoreData, err := EncodeJKSKeystore("pässword", key, chain)
+	if err != nil {
+		t.Fatalf("unexpected error encoding keystore: %v", err)
+	}
+	truststoreData, err := EncodeJKSTruststore("pässword", chain)
+	if err != nil {
+		t.Fatalf("unexpected error encoding truststore: %v", err)
+	}
+
+	for _, data := range [][]byte{keystoreData, truststoreData} {
+		if matches, err := PasswordMatchesJKSKeystore("pässword", data); err != nil || !matches {
+			t.Errorf("expected password to match keystore, got matches=%t err=%v", matches, err)
+		}
+		if matches, err := PasswordMatchesJKSKeystore("password", data); err != nil || matches {
+			t.Errorf("expected password not to match keystore, got matches=%t err=%v", matches, err)
+		}
+	}
+	if _, err := PasswordMatchesJKSKeystore("pässword", []byte("invalid")); err == nil {
+		t.Errorf("expected error checking password of invalid keystore")
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+var keyUsages = map[v1alpha1.KeyUsage]x509.KeyUsage{
+	v1alpha1.UsageSigning:           x509.KeyUsageDigitalSignature,
+	v1alpha1.UsageDigitalSignature:  x509.KeyUsageDigitalSignature,
+	v1alpha1.UsageContentCommitment: x509.KeyUsageContentCommitment,
+	v1alpha1.UsageKeyEncipherment:   x509.KeyUsageKeyEncipherment,
+	v1alpha1.UsageKeyAgreement:      x509.KeyUsageKeyAgreement,
+	v1alpha1.UsageDataEncipherment:  x509.KeyUsageDataEncipherment,
+	v1alpha1.UsageCertSign:          x509.KeyUsageCertSign,
+	v1alpha1.UsageCRLSign:           x509.KeyUsageCRLSign,
+	v1alpha1.UsageEncipherOnly:      x509.KeyUsageEncipherOnly,
+	v1alpha1.UsageDecipherOnly:      x509.KeyUsageDecipherOnly,
+}
+
+var extKeyUsages = map[v1alpha1.KeyUsage]x509.ExtKeyUsage{
+	v1alpha1.UsageAny:             x509.ExtKeyUsageAny,
+	v1alpha1.UsageServerAuth:      x509.ExtKeyUsageServerAuth,
+	v1alpha1.UsageClientAuth:      x509.ExtKeyUsageClientAuth,
+	v1alpha1.UsageCodeSigning:     x509.ExtKeyUsageCodeSigning,
+	v1alpha1.UsageEmailProtection: x509.ExtKeyUsageEmailProtection,
+	v1alpha1.UsageSMIME:           x509.ExtKeyUsageEmailProtection,
+	v1alpha1.UsageIPsecEndSystem:  x509.ExtKeyUsageIPSECEndSystem,
+	v1alpha1.UsageIPsecTunnel:     x509.ExtKeyUsageIPSECTunnel,
+	v1alpha1.UsageIPsecUser:       x509.ExtKeyUsageIPSECUser,
+	v1alpha1.UsageTimestamping:    x509.ExtKeyUsageTimeStamping,
+	v1alpha1.UsageOCSPSigning:     x509.ExtKeyUsageOCSPSigning,
+	v1alpha1.UsageMicrosoftSGC:    x509.ExtKeyUsageMicrosoftServerGatedCrypto,
+	v1alpha1.UsageNetscapeSGC:     x509.ExtKeyUsageNetscapeServerGatedCrypto,
+}
+
+// KeyUsageType returns the x509.KeyUsage corresponding to the given usage,
+// and false if the usage is not a key usage.
+func KeyUsageType(usage v1alpha1.KeyUsage) (x509.KeyUsage, bool) {
+	ku, ok := keyUsages[usage]
+	return ku, ok
+}
+
+// ExtKeyUsageType returns the x509.ExtKeyUsage corresponding to the given
+// usage, and false if the usage is not an extended key usage.
+func ExtKeyUsageType(usage v1alpha1.KeyUsage) (x509.ExtKeyUsage, bool) {
+	eku, ok := extKeyUsages[usage]
+	return eku, ok
+}
+
+// BuildKeyUsages returns the x509 key usages and extended key usages that
+// should be set on a certificate with the given usages.
+// If no usages are given, the 'digital signature' and 'key encipherment'
+// key usages are returned. If isCA is true, the 'cert sign' key usage is
+// always added.
+func BuildKeyUsages(usages []v1alpha1.KeyUsage, isCA bool) (x509.KeyUsage, []x509.ExtKeyUsage, error) {
+	var ku x509.KeyUsage
+	var eku []x509.ExtKeyUsage
+
+	if len(usages) == 0 {
+		ku = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+	}
+
+	for _, u := range usages {
+		if kt, ok := KeyUsageType(u); ok {
+			ku |= kt
+			continue
+		}
+		if et, ok := ExtKeyUsageType(u); ok {
+			eku = appendExtKeyUsage(eku, et)
+			continue
+		}
+		return 0, nil, fmt.Errorf("unknown key usage: %q", u)
+	}
+
+	if isCA {
+		ku |= x509.KeyUsageCertSign
+	}
+
+	return ku, eku, nil
+}
+
+func appendExtKeyUsage(in []x509.ExtKeyUsage, eku x509.ExtKeyUsage) []x509.ExtKeyUsage {
+	for _, e := range in {
+		if e == eku {
+			return in
+		}
+	}
+	return append(in, eku)
+}
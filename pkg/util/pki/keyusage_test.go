@@ -0,0 +1,92 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"reflect"
+	"testing"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+func TestBuildKeyUsages(t *testing.T) {
+	type testT struct {
+		name        string
+		usages      []v1alpha1.KeyUsage
+		isCA        bool
+		expectedKU  x509.KeyUsage
+		expectedEKU []x509.ExtKeyUsage
+		expectErr   bool
+	}
+	tests := []testT{
+		{
+			name:       "no usages set",
+			expectedKU: x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		},
+		{
+			name:       "no usages set for a CA",
+			isCA:       true,
+			expectedKU: x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		},
+		{
+			name:        "client auth only",
+			usages:      []v1alpha1.KeyUsage{v1alpha1.UsageDigitalSignature, v1alpha1.UsageClientAuth},
+			expectedKU:  x509.KeyUsageDigitalSignature,
+			expectedEKU: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		},
+		{
+			name:        "duplicate extended key usages",
+			usages:      []v1alpha1.KeyUsage{v1alpha1.UsageEmailProtection, v1alpha1.UsageSMIME, v1alpha1.UsageServerAuth},
+			expectedEKU: []x509.ExtKeyUsage{x509.ExtKeyUsageEmailProtection, x509.ExtKeyUsageServerAuth},
+		},
+		{
+			name:        "CA with ocsp signing",
+			usages:      []v1alpha1.KeyUsage{v1alpha1.UsageOCSPSigning},
+			isCA:        true,
+			expectedKU:  x509.KeyUsageCertSign,
+			expectedEKU: []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning},
+		},
+		{
+			name:      "unknown usage",
+			usages:    []v1alpha1.KeyUsage{"blah"},
+			expectErr: true,
+		},
+	}
+	testFn := func(test testT) func(*testing.T) {
+		return func(t *testing.T) {
+			ku, eku, err := BuildKeyUsages(test.usages, test.isCA)
+			if err != nil && !test.expectErr {
+				t.Errorf("expected no error but got: %v", err)
+				return
+			}
+			if err == nil && test.expectErr {
+				t.Errorf("expected an error but got none")
+				return
+			}
+			if ku != test.expectedKU {
+				t.Errorf("expected key usage %v but got %v", test.expectedKU, ku)
+			}
+			if !reflect.DeepEqual(eku, test.expectedEKU) {
+				t.Errorf("expected extended key usages %v but got %v", test.expectedEKU, eku)
+			}
+		}
+	}
+	for _, test := range tests {
+		t.Run(test.name, testFn(test))
+	}
+}
@@ -26,7 +26,7 @@ import (
 )
 
 // DecodePrivateKeyBytes will decode a PEM encoded private key into a crypto.Signer.
-// It supports ECDSA and RSA private keys only. All other types will return err.
+// It supports ECDSA, RSA and Ed25519 private keys only. All other types will return err.
 func DecodePrivateKeyBytes(keyBytes []byte) (crypto.Signer, error) {
 	// decode the private key pem
 	block, _ := pem.Decode(keyBytes)
@@ -126,3 +126,19 @@ func DecodeX509CertificateBytes(certBytes []byte) (*x509.Certificate, error) {
 
 	return certs[0], nil
 }
+
+// DecodeX509CertificateRequestBytes will decode a PEM encoded x509 Certificate
+// Signing Request.
+func DecodeX509CertificateRequestBytes(csrBytes []byte) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode(csrBytes)
+	if block == nil {
+		return nil, errors.NewInvalidData("error decoding certificate request PEM block")
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, errors.NewInvalidData("error parsing certificate request: %s", err.Error())
+	}
+
+	return csr, nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"fmt"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// EncodePKCS12Keystore will encode the given private key and certificate
+// chain into a PKCS#12 keystore, protected using the given password.
+// The first certificate in the chain must correspond to the private key.
+func EncodePKCS12Keystore(password string, key crypto.PrivateKey, chain []*x509.Certificate) ([]byte, error) {
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("error encoding PKCS#12 keystore: no certificates provided")
+	}
+	data, err := pkcs12.Encode(rand.Reader, key, chain[0], chain[1:], password)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding PKCS#12 keystore: %v", err)
+	}
+	return data, nil
+}
+
+// PasswordMatchesPKCS12Keystore returns true if the given password is the
+// password used to protect the given PKCS#12 keystore.
+func PasswordMatchesPKCS12Keystore(password string, data []byte) (bool, error) {
+	_, _, _, err := pkcs12.DecodeChain(data, password)
+	if err == pkcs12.ErrIncorrectPassword {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error decoding PKCS#12 keystore: %v", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"software.sslmate.com/src/go-pkcs12"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+// generateTestChain returns a private key along with a certificate chain
+// consisting of a leaf certificate for that key and its issuing CA.
+func generateTestChain(t *testing.T) (crypto.Signer, []*x509.Certificate) {
+	caKey, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatalf("error generating ca private key: %v", err)
+	}
+	caTemplate, err := GenerateTemplate(buildCertificateWithKeyParams(v1alpha1.ECDSAKeyAlgorithm, 256))
+	if err != nil {
+		t.Fatalf("error generating ca template: %v", err)
+	}
+	caTemplate.IsCA = true
+	caTemplate.KeyUsage |= x509.KeyUsageCertSign
+	_, ca, err := SignCertificate(caTemplate, caTemplate, caKey.Public(), caKey)
+	if err != nil {
+		t.Fatalf("error signing ca certificate: %v", err)
+	}
+
+	key, err := GenerateRSAPrivateKey(MinRSAKeySize)
+	if err != nil {
+		t.Fatalf("error generating private key: %v", err)
+	}
+	template, err := GenerateTemplate(buildCertificateWithKeyParams(v1alpha1.RSAKeyAlgorithm, MinRSAKeySize))
+	if err != nil {
+		t.Fatalf("error generating template: %v", err)
+	}
+	template.NotAfter = time.Now().Add(time.Hour)
+	_, cert, err := SignCertificate(template, ca, key.Public(), caKey)
+	if err != nil {
+		t.Fatalf("error signing certificate: %v", err)
+	}
+
+	return key, []*x509.Certificate{cert, ca}
+}
+
+func TestEncodePKCS12Keystore(t *testing.T) {
+	key, chain := generateTestChain(t)
+
+	data, err := EncodePKCS12Keystore("pässword", key, chain)
+	if err != nil {
+		t.Fatalf("unexpected error encoding keystore: %v", err)
+	}
+
+	decodedKey, cert, caCerts, err := pkcs12.DecodeChain(data, "pässword")
+	if err != nil {
+		t.Fatalf("error decoding keystore: %v", err)
+	}
+	if !chain[0].Equal(cert) {
+		t.Errorf("certificate does not match")
+	}
+	if len(caCerts) != 1 || !chain[1].Equal(caCerts[0]) {
+		t.Errorf("expected CA certificate to match, got %d CA certificates", len(caCerts))
+	}
+	matches, err := PublicKeyMatchesCertificate(decodedKey.(crypto.Signer).Public(), chain[0])
+	if err != nil || !matches {
+		t.Errorf("decoded private key does not match certificate")
+	}
+
+	if _, err := EncodePKCS12Keystore("password", key, nil); err == nil {
+		t.Errorf("expected error encoding keystore with no certificates")
+	}
+}
+
+func TestPasswordMatchesPKCS12Keystore(t *testing.T) {
+	key, chain := generateTestChain(t)
+	data, err := EncodePKCS12Keystore("pässword", key, chain)
+	if err != nil {
+		t.Fatalf("unexpected error encoding keystore: %v", err)
+	}
+
+	if matches, err := PasswordMatchesPKCS12Keystore("pässword", data); err != nil || !matches {
+		t.Errorf("expected password to match keystore, got matches=%t err=%v", matches, err)
+	}
+	if matches, err := PasswordMatchesPKCS12Keystore("password", data); err != nil || matches {
+		t.Errorf("expected password not to match keystore, got matches=%t err=%v", matches, err)
+	}
+	if _, err := PasswordMatchesPKCS12Keystore("pässword", []byte("invalid")); err == nil {
+		t.Errorf("expected error checking password of invalid keystore")
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion implements a CustomResourceDefinition conversion webhook
+// that converts cert-manager resources between the API versions served by the
+// cert-manager.io API group.
+package conversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/klog"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/install"
+)
+
+// Webhook converts cert-manager resources between API versions.
+// It implements http.Handler, and should be served on the path configured in
+// the 'conversion' stanza of each of the cert-manager CustomResourceDefinitions.
+type Webhook struct {
+	scheme       *runtime.Scheme
+	deserializer runtime.Decoder
+}
+
+// NewWebhook returns a conversion webhook that is able to convert between all
+// versions of the cert-manager.io API group.
+func NewWebhook() *Webhook {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+	return &Webhook{
+		scheme:       scheme,
+		deserializer: serializer.NewCodecFactory(scheme).UniversalDeserializer(),
+	}
+}
+
+// ServeHTTP decodes a ConversionReview from the request body, converts each
+// of the objects in the review and writes the ConversionReview back to the
+// client with the response set.
+func (w *Webhook) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, fmt.Sprintf("unsupported method %q", req.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("failed to read request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	review := &apiextensionsv1beta1.ConversionReview{}
+	if err := json.Unmarshal(body, review); err != nil {
+		http.Error(rw, fmt.Sprintf("failed to decode ConversionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(rw, "ConversionReview does not contain a request", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = w.Convert(review.Request)
+	review.Request = nil
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(review); err != nil {
+		klog.Errorf("failed to write ConversionReview response: %v", err)
+	}
+}
+
+// Convert converts all of the objects in the given ConversionRequest to the
+// desired API version. If any object fails to convert, the returned response
+// will have a failure status and no converted objects.
+func (w *Webhook) Convert(req *apiextensionsv1beta1.ConversionRequest) *apiextensionsv1beta1.ConversionResponse {
+	resp := &apiextensionsv1beta1.ConversionResponse{
+		UID: req.UID,
+	}
+
+	desiredGV, err := schema.ParseGroupVersion(req.DesiredAPIVersion)
+	if err != nil {
+		resp.Result = failureStatus(fmt.Errorf("invalid desired API version %q: %v", req.DesiredAPIVersion, err))
+		return resp
+	}
+	if !w.scheme.IsVersionRegistered(desiredGV) || desiredGV.Version == runtime.APIVersionInternal {
+		resp.Result = failureStatus(fmt.Errorf("unsupported desired API version %q", req.DesiredAPIVersion))
+		return resp
+	}
+
+	converted := make([]runtime.RawExtension, 0, len(req.Objects))
+	for i, raw := range req.Objects {
+		out, err := w.convertObject(raw.Raw, desiredGV)
+		if err != nil {
+			klog.Errorf("failed to convert object %d in request %q: %v", i, req.UID, err)
+			resp.Result = failureStatus(err)
+			return resp
+		}
+		converted = append(converted, runtime.RawExtension{Raw: out})
+	}
+
+	resp.ConvertedObjects = converted
+	resp.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return resp
+}
+
+// convertObject decodes the given JSON encoded object and converts it to the
+// desired version, via the internal version of the API group.
+func (w *Webhook) convertObject(data []byte, desiredGV schema.GroupVersion) ([]byte, error) {
+	obj, gvk, err := w.deserializer.Decode(data, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode object: %v", err)
+	}
+	if gvk.Group != certmanager.GroupName {
+		return nil, fmt.Errorf("unsupported API group %q", gvk.Group)
+	}
+
+	internal, err := w.scheme.ConvertToVersion(obj, certmanager.SchemeGroupVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s to internal version: %v", gvk.Kind, err)
+	}
+
+	out, err := w.scheme.ConvertToVersion(internal, desiredGV)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s to %s: %v", gvk.Kind, desiredGV, err)
+	}
+
+	return json.Marshal(out)
+}
+
+func failureStatus(err error) metav1.Status {
+	return metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: err.Error(),
+	}
+}
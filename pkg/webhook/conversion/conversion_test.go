@@ -0,0 +1,449 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha2"
+)
+
+func mustMarshal(t *testing.T, obj interface{}) runtime.RawExtension {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal object: %v", err)
+	}
+	return runtime.RawExtension{Raw: data}
+}
+
+func TestConvertCertificate(t *testing.T) {
+	crt := &v1alpha1.Certificate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha1", Kind: "Certificate"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: v1alpha1.CertificateSpec{
+			SecretName: "test-tls",
+			DNSNames:   []string{"example.com"},
+			IssuerRef:  v1alpha1.ObjectReference{Name: "issuer"},
+			ACME: &v1alpha1.ACMECertificateConfig{
+				Config: []v1alpha1.DomainSolverConfig{
+					{
+						Domains: []string{"example.com"},
+						SolverConfig: v1alpha1.SolverConfig{
+							HTTP01: &v1alpha1.HTTP01SolverConfig{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resp := NewWebhook().Convert(&apiextensionsv1beta1.ConversionRequest{
+		UID:               "abc",
+		DesiredAPIVersion: "cert-manager.io/v1alpha2",
+		Objects:           []runtime.RawExtension{mustMarshal(t, crt)},
+	})
+	if resp.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("expected conversion to succeed, got: %s", resp.Result.Message)
+	}
+	if resp.UID != "abc" {
+		t.Errorf("expected response UID to be %q but got %q", "abc", resp.UID)
+	}
+	if len(resp.ConvertedObjects) != 1 {
+		t.Fatalf("expected 1 converted object but got %d", len(resp.ConvertedObjects))
+	}
+
+	out := &v1alpha2.Certificate{}
+	if err := json.Unmarshal(resp.ConvertedObjects[0].Raw, out); err != nil {
+		t.Fatalf("failed to decode converted object: %v", err)
+	}
+	if out.APIVersion != "cert-manager.io/v1alpha2" || out.Kind != "Certificate" {
+		t.Errorf("unexpected type meta on converted object: %v", out.TypeMeta)
+	}
+	if out.Name != "test" || out.Namespace != "default" {
+		t.Errorf("unexpected object meta on converted object: %v", out.ObjectMeta)
+	}
+	if out.Spec.SecretName != "test-tls" || !reflect.DeepEqual(out.Spec.DNSNames, []string{"example.com"}) {
+		t.Errorf("unexpected spec on converted object: %+v", out.Spec)
+	}
+	if _, ok := out.Annotations[v1alpha2.DeprecatedFieldsAnnotationKey]; !ok {
+		t.Errorf("expected deprecated 'acme' field to be stored in the %q annotation, got: %s", v1alpha2.DeprecatedFieldsAnnotationKey, resp.ConvertedObjects[0].Raw)
+	}
+}
+
+func TestConvertRoundTripDeprecatedFields(t *testing.T) {
+	meta := metav1.ObjectMeta{
+		Name:        "test",
+		Namespace:   "default",
+		Annotations: map[string]string{"example.com/annotation": "value"},
+	}
+	solverConfig := v1alpha1.SolverConfig{
+		DNS01: &v1alpha1.DNS01SolverConfig{Provider: "cloudflare"},
+	}
+	tests := map[string]runtime.Object{
+		"certificate": &v1alpha1.Certificate{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha1", Kind: "Certificate"},
+			ObjectMeta: meta,
+			Spec: v1alpha1.CertificateSpec{
+				SecretName: "test-tls",
+				DNSNames:   []string{"example.com"},
+				IssuerRef:  v1alpha1.ObjectReference{Name: "issuer"},
+				ACME: &v1alpha1.ACMECertificateConfig{
+					Config: []v1alpha1.DomainSolverConfig{
+						{Domains: []string{"example.com"}, SolverConfig: solverConfig},
+					},
+				},
+			},
+		},
+		"issuer": &v1alpha1.Issuer{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha1", Kind: "Issuer"},
+			ObjectMeta: meta,
+			Spec: v1alpha1.IssuerSpec{IssuerConfig: v1alpha1.IssuerConfig{ACME: &v1alpha1.ACMEIssuer{
+				Server: "https://acme",
+				PrivateKey: v1alpha1.SecretKeySelector{
+					LocalObjectReference: v1alpha1.LocalObjectReference{Name: "key"},
+				},
+				HTTP01: &v1alpha1.ACMEIssuerHTTP01Config{ServiceType: "ClusterIP"},
+				DNS01: &v1alpha1.ACMEIssuerDNS01Config{
+					Providers: []v1alpha1.ACMEIssuerDNS01Provider{{Name: "cloudflare"}},
+				},
+			}}},
+		},
+		"cluster issuer without annotations": &v1alpha1.ClusterIssuer{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha1", Kind: "ClusterIssuer"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: v1alpha1.IssuerSpec{IssuerConfig: v1alpha1.IssuerConfig{ACME: &v1alpha1.ACMEIssuer{
+				Server: "https://acme",
+				PrivateKey: v1alpha1.SecretKeySelector{
+					LocalObjectReference: v1alpha1.LocalObjectReference{Name: "key"},
+				},
+				HTTP01: &v1alpha1.ACMEIssuerHTTP01Config{},
+			}}},
+		},
+		"order": &v1alpha1.Order{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha1", Kind: "Order"},
+			ObjectMeta: meta,
+			Spec: v1alpha1.OrderSpec{
+				CSR:      []byte("csr"),
+				DNSNames: []string{"example.com"},
+				Config: []v1alpha1.DomainSolverConfig{
+					{Domains: []string{"example.com"}, SolverConfig: solverConfig},
+				},
+			},
+		},
+		"challenge": &v1alpha1.Challenge{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha1", Kind: "Challenge"},
+			ObjectMeta: meta,
+			Spec: v1alpha1.ChallengeSpec{
+				Type:    "dns-01",
+				DNSName: "example.com",
+				Config:  &solverConfig,
+			},
+		},
+	}
+	for name, in := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := NewWebhook().Convert(&apiextensionsv1beta1.ConversionRequest{
+				DesiredAPIVersion: "cert-manager.io/v1alpha2",
+				Objects:           []runtime.RawExtension{mustMarshal(t, in)},
+			})
+			if resp.Result.Status != metav1.StatusSuccess {
+				t.Fatalf("expected conversion to v1alpha2 to succeed, got: %s", resp.Result.Message)
+			}
+
+			resp = NewWebhook().Convert(&apiextensionsv1beta1.ConversionRequest{
+				DesiredAPIVersion: "cert-manager.io/v1alpha1",
+				Objects:           resp.ConvertedObjects,
+			})
+			if resp.Result.Status != metav1.StatusSuccess {
+				t.Fatalf("expected conversion to v1alpha1 to succeed, got: %s", resp.Result.Message)
+			}
+
+			out := reflect.New(reflect.TypeOf(in).Elem()).Interface()
+			if err := json.Unmarshal(resp.ConvertedObjects[0].Raw, out); err != nil {
+				t.Fatalf("failed to decode converted object: %v", err)
+			}
+			if !reflect.DeepEqual(out, in) {
+				t.Errorf("expected object to be unchanged after round trip.\nexp=%+v\ngot=%+v", in, out)
+			}
+		})
+	}
+}
+
+func TestConvertVaultIssuer(t *testing.T) {
+	tests := map[string]struct {
+		in  runtime.Object
+		out runtime.Object
+		gv  string
+	}{
+		"token auth from v1alpha1 to v1alpha2": {
+			in: &v1alpha1.Issuer{
+				TypeMeta: metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha1", Kind: "Issuer"},
+				Spec: v1alpha1.IssuerSpec{IssuerConfig: v1alpha1.IssuerConfig{Vault: &v1alpha1.VaultIssuer{
+					Server: "https://vault",
+					Path:   "pki/sign/example",
+					Auth: v1alpha1.VaultAuth{
+						TokenSecretRef: v1alpha1.SecretKeySelector{
+							LocalObjectReference: v1alpha1.LocalObjectReference{Name: "token"},
+							Key:                  "key",
+						},
+					},
+				}}},
+			},
+			out: &v1alpha2.Issuer{
+				TypeMeta: metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha2", Kind: "Issuer"},
+				Spec: v1alpha2.IssuerSpec{IssuerConfig: v1alpha2.IssuerConfig{Vault: &v1alpha2.VaultIssuer{
+					Server: "https://vault",
+					Path:   "pki/sign/example",
+					Auth: v1alpha2.VaultAuth{
+						TokenSecretRef: &v1alpha2.SecretKeySelector{
+							LocalObjectReference: v1alpha2.LocalObjectReference{Name: "token"},
+							Key:                  "key",
+						},
+					},
+				}}},
+			},
+			gv: "cert-manager.io/v1alpha2",
+		},
+		"approle auth from v1alpha2 to v1alpha1": {
+			in: &v1alpha2.ClusterIssuer{
+				TypeMeta: metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha2", Kind: "ClusterIssuer"},
+				Spec: v1alpha2.IssuerSpec{IssuerConfig: v1alpha2.IssuerConfig{Vault: &v1alpha2.VaultIssuer{
+					Server: "https://vault",
+					Path:   "pki/sign/example",
+					Auth: v1alpha2.VaultAuth{
+						AppRole: &v1alpha2.VaultAppRole{
+							Path:   "approle",
+							RoleId: "role",
+							SecretRef: v1alpha2.SecretKeySelector{
+								LocalObjectReference: v1alpha2.LocalObjectReference{Name: "secret"},
+							},
+						},
+					},
+				}}},
+			},
+			out: &v1alpha1.ClusterIssuer{
+				TypeMeta: metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha1", Kind: "ClusterIssuer"},
+				Spec: v1alpha1.IssuerSpec{IssuerConfig: v1alpha1.IssuerConfig{Vault: &v1alpha1.VaultIssuer{
+					Server: "https://vault",
+					Path:   "pki/sign/example",
+					Auth: v1alpha1.VaultAuth{
+						AppRole: v1alpha1.VaultAppRole{
+							Path:   "approle",
+							RoleId: "role",
+							SecretRef: v1alpha1.SecretKeySelector{
+								LocalObjectReference: v1alpha1.LocalObjectReference{Name: "secret"},
+							},
+						},
+					},
+				}}},
+			},
+			gv: "cert-manager.io/v1alpha1",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := NewWebhook().Convert(&apiextensionsv1beta1.ConversionRequest{
+				DesiredAPIVersion: test.gv,
+				Objects:           []runtime.RawExtension{mustMarshal(t, test.in)},
+			})
+			if resp.Result.Status != metav1.StatusSuccess {
+				t.Fatalf("expected conversion to succeed, got: %s", resp.Result.Message)
+			}
+
+			out := reflect.New(reflect.TypeOf(test.out).Elem()).Interface()
+			if err := json.Unmarshal(resp.ConvertedObjects[0].Raw, out); err != nil {
+				t.Fatalf("failed to decode converted object: %v", err)
+			}
+			if !reflect.DeepEqual(out, test.out) {
+				t.Errorf("unexpected converted object.\nexp=%+v\ngot=%+v", test.out, out)
+			}
+		})
+	}
+}
+
+func TestConvertSecretReferences(t *testing.T) {
+	v1alpha1Issuer := &v1alpha1.Issuer{
+		TypeMeta: metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha1", Kind: "Issuer"},
+		Spec: v1alpha1.IssuerSpec{IssuerConfig: v1alpha1.IssuerConfig{ACME: &v1alpha1.ACMEIssuer{
+			Server: "https://acme",
+			PrivateKey: v1alpha1.SecretKeySelector{
+				LocalObjectReference: v1alpha1.LocalObjectReference{Name: "account-key"},
+			},
+			Solvers: []v1alpha1.ACMEChallengeSolver{
+				{
+					DNS01: &v1alpha1.ACMEChallengeSolverDNS01{
+						Cloudflare: &v1alpha1.ACMEIssuerDNS01ProviderCloudflare{
+							Email: "test@example.com",
+							APIKey: v1alpha1.SecretKeySelector{
+								LocalObjectReference: v1alpha1.LocalObjectReference{Name: "cloudflare"},
+								Key:                  "api-key",
+							},
+						},
+					},
+				},
+			},
+		}}},
+	}
+	v1alpha2Issuer := &v1alpha2.Issuer{
+		TypeMeta: metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha2", Kind: "Issuer"},
+		Spec: v1alpha2.IssuerSpec{IssuerConfig: v1alpha2.IssuerConfig{ACME: &v1alpha2.ACMEIssuer{
+			Server: "https://acme",
+			PrivateKeySecretRef: v1alpha2.SecretKeySelector{
+				LocalObjectReference: v1alpha2.LocalObjectReference{Name: "account-key"},
+			},
+			Solvers: []v1alpha2.ACMEChallengeSolver{
+				{
+					DNS01: &v1alpha2.ACMEChallengeSolverDNS01{
+						Cloudflare: &v1alpha2.ACMEIssuerDNS01ProviderCloudflare{
+							Email: "test@example.com",
+							APIKeySecretRef: v1alpha2.SecretKeySelector{
+								LocalObjectReference: v1alpha2.LocalObjectReference{Name: "cloudflare"},
+								Key:                  "api-key",
+							},
+						},
+					},
+				},
+			},
+		}}},
+	}
+
+	tests := map[string]struct {
+		in  runtime.Object
+		out runtime.Object
+		gv  string
+	}{
+		"from v1alpha1 to v1alpha2": {
+			in:  v1alpha1Issuer,
+			out: v1alpha2Issuer,
+			gv:  "cert-manager.io/v1alpha2",
+		},
+		"from v1alpha2 to v1alpha1": {
+			in:  v1alpha2Issuer,
+			out: v1alpha1Issuer,
+			gv:  "cert-manager.io/v1alpha1",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := NewWebhook().Convert(&apiextensionsv1beta1.ConversionRequest{
+				DesiredAPIVersion: test.gv,
+				Objects:           []runtime.RawExtension{mustMarshal(t, test.in)},
+			})
+			if resp.Result.Status != metav1.StatusSuccess {
+				t.Fatalf("expected conversion to succeed, got: %s", resp.Result.Message)
+			}
+
+			out := reflect.New(reflect.TypeOf(test.out).Elem()).Interface()
+			if err := json.Unmarshal(resp.ConvertedObjects[0].Raw, out); err != nil {
+				t.Fatalf("failed to decode converted object: %v", err)
+			}
+			if !reflect.DeepEqual(out, test.out) {
+				t.Errorf("unexpected converted object.\nexp=%+v\ngot=%+v", test.out, out)
+			}
+		})
+	}
+}
+
+func TestConvertFailures(t *testing.T) {
+	crt := mustMarshal(t, &v1alpha1.Certificate{
+		TypeMeta: metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha1", Kind: "Certificate"},
+	})
+	tests := map[string]*apiextensionsv1beta1.ConversionRequest{
+		"unknown desired version": {
+			DesiredAPIVersion: "cert-manager.io/v1",
+			Objects:           []runtime.RawExtension{crt},
+		},
+		"internal desired version": {
+			DesiredAPIVersion: "cert-manager.io/__internal",
+			Objects:           []runtime.RawExtension{crt},
+		},
+		"object from another group": {
+			DesiredAPIVersion: "cert-manager.io/v1alpha2",
+			Objects:           []runtime.RawExtension{{Raw: []byte(`{"apiVersion":"v1","kind":"Secret"}`)}},
+		},
+		"malformed object": {
+			DesiredAPIVersion: "cert-manager.io/v1alpha2",
+			Objects:           []runtime.RawExtension{{Raw: []byte(`{`)}},
+		},
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := NewWebhook().Convert(req)
+			if resp.Result.Status != metav1.StatusFailure {
+				t.Errorf("expected conversion to fail")
+			}
+			if len(resp.ConvertedObjects) != 0 {
+				t.Errorf("expected no converted objects but got %d", len(resp.ConvertedObjects))
+			}
+		})
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	review := &apiextensionsv1beta1.ConversionReview{
+		Request: &apiextensionsv1beta1.ConversionRequest{
+			UID:               "abc",
+			DesiredAPIVersion: "cert-manager.io/v1alpha2",
+			Objects: []runtime.RawExtension{mustMarshal(t, &v1alpha1.Order{
+				TypeMeta: metav1.TypeMeta{APIVersion: "cert-manager.io/v1alpha1", Kind: "Order"},
+				Spec:     v1alpha1.OrderSpec{DNSNames: []string{"example.com"}},
+			})},
+		},
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	NewWebhook().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/convert", bytes.NewReader(body)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status code 200 but got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	out := &apiextensionsv1beta1.ConversionReview{}
+	if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if out.Response == nil || out.Response.UID != "abc" || out.Response.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("unexpected response: %+v", out.Response)
+	}
+
+	order := &v1alpha2.Order{}
+	if err := json.Unmarshal(out.Response.ConvertedObjects[0].Raw, order); err != nil {
+		t.Fatalf("failed to decode converted object: %v", err)
+	}
+	if order.APIVersion != "cert-manager.io/v1alpha2" || !reflect.DeepEqual(order.Spec.DNSNames, []string{"example.com"}) {
+		t.Errorf("unexpected converted object: %+v", order)
+	}
+
+	rec = httptest.NewRecorder()
+	NewWebhook().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/convert", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status code 405 for GET request but got %d", rec.Code)
+	}
+}
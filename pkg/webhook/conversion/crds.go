@@ -0,0 +1,133 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"reflect"
+	"time"
+
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+)
+
+const (
+	// WebhookPath is the path that the conversion webhook is served on.
+	WebhookPath = "/convert"
+
+	// injectCAFromAnnotation is the annotation used to instruct the cainjector
+	// to inject the CA of a Certificate into a CustomResourceDefinition's
+	// conversion webhook configuration.
+	injectCAFromAnnotation = "certmanager.k8s.io/inject-ca-from"
+
+	// crdResyncPeriod is how often the CustomResourceDefinitions are checked,
+	// so that the configuration is restored if the CRD manifests are applied
+	// again.
+	crdResyncPeriod = time.Minute
+)
+
+// crdResources are the resources of the CustomResourceDefinitions that are
+// converted by the webhook.
+var crdResources = []string{"certificates", "certificaterequests", "challenges", "clusterissuers", "issuers", "orders"}
+
+// CRDConfigurer configures the cert-manager CustomResourceDefinitions to
+// convert resources between API versions using the conversion webhook, and
+// to serve all of their versions.
+// The CRD manifests do not configure webhook conversion themselves, as it is
+// not supported by all Kubernetes versions and the webhook's Service depends
+// on how cert-manager has been installed.
+type CRDConfigurer struct {
+	Client apiextensionsclient.CustomResourceDefinitionsGetter
+
+	// ServiceNamespace and ServiceName identify the Service that the webhook
+	// is served behind.
+	ServiceNamespace string
+	ServiceName      string
+
+	// CAFrom is the namespace/name of the Certificate whose CA is injected
+	// into each CustomResourceDefinition by the cainjector.
+	CAFrom string
+}
+
+// Run configures the CustomResourceDefinitions, and checks that they are
+// still configured every crdResyncPeriod until stopCh is closed.
+func (c *CRDConfigurer) Run(stopCh <-chan struct{}) {
+	wait.Until(c.configureAll, crdResyncPeriod, stopCh)
+}
+
+func (c *CRDConfigurer) configureAll() {
+	for _, resource := range crdResources {
+		name := resource + "." + certmanager.GroupName
+		if err := c.configure(name); err != nil {
+			klog.Errorf("failed to configure conversion for CustomResourceDefinition %q: %v", name, err)
+		}
+	}
+}
+
+func (c *CRDConfigurer) configure(name string) error {
+	crd, err := c.Client.CustomResourceDefinitions().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	updated := crd.DeepCopy()
+	c.setConversion(updated)
+	if reflect.DeepEqual(crd, updated) {
+		return nil
+	}
+
+	if _, err := c.Client.CustomResourceDefinitions().Update(updated); err != nil {
+		return err
+	}
+	klog.Infof("configured conversion webhook for CustomResourceDefinition %q", name)
+	return nil
+}
+
+// setConversion sets the conversion webhook configuration of the given
+// CustomResourceDefinition and marks all of its versions as served. Any CA
+// bundle that has already been injected is kept.
+func (c *CRDConfigurer) setConversion(crd *apiextensionsv1beta1.CustomResourceDefinition) {
+	if crd.Annotations == nil {
+		crd.Annotations = make(map[string]string)
+	}
+	crd.Annotations[injectCAFromAnnotation] = c.CAFrom
+
+	var caBundle []byte
+	if crd.Spec.Conversion != nil && crd.Spec.Conversion.WebhookClientConfig != nil {
+		caBundle = crd.Spec.Conversion.WebhookClientConfig.CABundle
+	}
+	path := WebhookPath
+	crd.Spec.Conversion = &apiextensionsv1beta1.CustomResourceConversion{
+		Strategy: apiextensionsv1beta1.WebhookConverter,
+		WebhookClientConfig: &apiextensionsv1beta1.WebhookClientConfig{
+			Service: &apiextensionsv1beta1.ServiceReference{
+				Namespace: c.ServiceNamespace,
+				Name:      c.ServiceName,
+				Path:      &path,
+			},
+			CABundle: caBundle,
+		},
+	}
+
+	for i := range crd.Spec.Versions {
+		crd.Spec.Versions[i].Served = true
+	}
+}
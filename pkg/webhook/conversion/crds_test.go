@@ -0,0 +1,128 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"reflect"
+	"testing"
+
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetConversion(t *testing.T) {
+	c := &CRDConfigurer{
+		ServiceNamespace: "example",
+		ServiceName:      "example-webhook",
+		CAFrom:           "example/example-webhook-webhook-tls",
+	}
+	path := WebhookPath
+	expConversion := func(caBundle []byte) *apiextensionsv1beta1.CustomResourceConversion {
+		return &apiextensionsv1beta1.CustomResourceConversion{
+			Strategy: apiextensionsv1beta1.WebhookConverter,
+			WebhookClientConfig: &apiextensionsv1beta1.WebhookClientConfig{
+				Service: &apiextensionsv1beta1.ServiceReference{
+					Namespace: "example",
+					Name:      "example-webhook",
+					Path:      &path,
+				},
+				CABundle: caBundle,
+			},
+		}
+	}
+	versions := func(v1alpha2Served bool) []apiextensionsv1beta1.CustomResourceDefinitionVersion {
+		return []apiextensionsv1beta1.CustomResourceDefinitionVersion{
+			{Name: "v1alpha1", Served: true, Storage: true},
+			{Name: "v1alpha2", Served: v1alpha2Served},
+		}
+	}
+
+	tests := map[string]struct {
+		crd *apiextensionsv1beta1.CustomResourceDefinition
+		exp *apiextensionsv1beta1.CustomResourceDefinition
+	}{
+		"configure a CRD using the None conversion strategy": {
+			crd: &apiextensionsv1beta1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "certificates.cert-manager.io"},
+				Spec: apiextensionsv1beta1.CustomResourceDefinitionSpec{
+					Versions: versions(false),
+				},
+			},
+			exp: &apiextensionsv1beta1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "certificates.cert-manager.io",
+					Annotations: map[string]string{injectCAFromAnnotation: "example/example-webhook-webhook-tls"},
+				},
+				Spec: apiextensionsv1beta1.CustomResourceDefinitionSpec{
+					Versions:   versions(true),
+					Conversion: expConversion(nil),
+				},
+			},
+		},
+		"keep an injected CA bundle and update a stale service": {
+			crd: &apiextensionsv1beta1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "certificates.cert-manager.io",
+					Annotations: map[string]string{"other": "annotation"},
+				},
+				Spec: apiextensionsv1beta1.CustomResourceDefinitionSpec{
+					Versions: versions(true),
+					Conversion: &apiextensionsv1beta1.CustomResourceConversion{
+						Strategy: apiextensionsv1beta1.WebhookConverter,
+						WebhookClientConfig: &apiextensionsv1beta1.WebhookClientConfig{
+							Service: &apiextensionsv1beta1.ServiceReference{
+								Namespace: "cert-manager",
+								Name:      "cert-manager-webhook",
+							},
+							CABundle: []byte("ca"),
+						},
+					},
+				},
+			},
+			exp: &apiextensionsv1beta1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "certificates.cert-manager.io",
+					Annotations: map[string]string{
+						"other":                "annotation",
+						injectCAFromAnnotation: "example/example-webhook-webhook-tls",
+					},
+				},
+				Spec: apiextensionsv1beta1.CustomResourceDefinitionSpec{
+					Versions:   versions(true),
+					Conversion: expConversion([]byte("ca")),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c.setConversion(test.crd)
+			if !reflect.DeepEqual(test.crd, test.exp) {
+				t.Errorf("unexpected CustomResourceDefinition, exp=%+v, got=%+v", test.exp, test.crd)
+			}
+
+			// configuring an already configured CRD must not change it, so
+			// that it is not updated on every resync
+			configured := test.crd.DeepCopy()
+			c.setConversion(configured)
+			if !reflect.DeepEqual(configured, test.crd) {
+				t.Errorf("expected CustomResourceDefinition to be unchanged, exp=%+v, got=%+v", test.crd, configured)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package defaulting implements mutating admission hooks that persist the
+// default values of cert-manager resources when they are created or updated.
+package defaulting
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/appscode/jsonpatch"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	restclient "k8s.io/client-go/rest"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/install"
+)
+
+// AdmissionHook is a mutating admission hook that applies the defaulting
+// functions registered for a cert-manager resource, and returns the
+// difference as a JSON patch.
+type AdmissionHook struct {
+	resource string
+	singular string
+
+	scheme       *runtime.Scheme
+	deserializer runtime.Decoder
+}
+
+// NewCertificateAdmissionHook returns a hook that defaults Certificates.
+func NewCertificateAdmissionHook() *AdmissionHook {
+	return newAdmissionHook("certificatedefaults", "certificatedefault")
+}
+
+// NewIssuerAdmissionHook returns a hook that defaults Issuers.
+func NewIssuerAdmissionHook() *AdmissionHook {
+	return newAdmissionHook("issuerdefaults", "issuerdefault")
+}
+
+// NewClusterIssuerAdmissionHook returns a hook that defaults ClusterIssuers.
+func NewClusterIssuerAdmissionHook() *AdmissionHook {
+	return newAdmissionHook("clusterissuerdefaults", "clusterissuerdefault")
+}
+
+func newAdmissionHook(resource, singular string) *AdmissionHook {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+	return &AdmissionHook{
+		resource:     resource,
+		singular:     singular,
+		scheme:       scheme,
+		deserializer: serializer.NewCodecFactory(scheme).UniversalDeserializer(),
+	}
+}
+
+func (h *AdmissionHook) Initialize(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) error {
+	return nil
+}
+
+func (h *AdmissionHook) MutatingResource() (plural schema.GroupVersionResource, singular string) {
+	return schema.GroupVersionResource{
+		Group:    "admission." + certmanager.GroupName,
+		Version:  "v1beta1",
+		Resource: h.resource,
+	}, h.singular
+}
+
+func (h *AdmissionHook) Admit(admissionSpec *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	status := &admissionv1beta1.AdmissionResponse{
+		UID:     admissionSpec.UID,
+		Allowed: true,
+	}
+
+	if admissionSpec.Operation != admissionv1beta1.Create && admissionSpec.Operation != admissionv1beta1.Update {
+		return status
+	}
+
+	patch, err := h.defaultingPatch(admissionSpec.Object.Raw)
+	if err != nil {
+		status.Allowed = false
+		status.Result = &metav1.Status{
+			Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+			Message: err.Error(),
+		}
+		return status
+	}
+
+	if len(patch) > 0 {
+		patchType := admissionv1beta1.PatchTypeJSONPatch
+		status.Patch = patch
+		status.PatchType = &patchType
+	}
+
+	return status
+}
+
+// defaultingPatch decodes the given object, applies the registered defaults
+// and returns a JSON patch that transforms the original object into the
+// defaulted one. If no fields need to be changed, an empty patch is returned.
+func (h *AdmissionHook) defaultingPatch(raw []byte) ([]byte, error) {
+	obj, gvk, err := h.deserializer.Decode(raw, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode object: %v", err)
+	}
+	if gvk.Group != certmanager.GroupName {
+		return nil, fmt.Errorf("unsupported API group %q", gvk.Group)
+	}
+
+	h.scheme.Default(obj)
+
+	defaulted, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode defaulted object: %v", err)
+	}
+
+	ops, err := jsonpatch.CreatePatch(raw, defaulted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create patch: %v", err)
+	}
+	if len(ops) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(ops)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulting
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/appscode/jsonpatch"
+	jsonpatchapply "github.com/evanphx/json-patch"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager/validation"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestAdmit(t *testing.T) {
+	tests := map[string]struct {
+		hook      *AdmissionHook
+		operation admissionv1beta1.Operation
+		object    string
+		expOps    []jsonpatch.Operation
+		expDenied bool
+	}{
+		"defaults rsa certificate": {
+			hook:      NewCertificateAdmissionHook(),
+			operation: admissionv1beta1.Create,
+			object: `{"apiVersion":"cert-manager.io/v1alpha1","kind":"Certificate","metadata":{"name":"test","creationTimestamp":null},
+				"spec":{"secretName":"test","issuerRef":{"name":"issuer"},"dnsNames":["example.com"]},"status":{}}`,
+			expOps: []jsonpatch.Operation{
+				jsonpatch.NewPatch("add", "/spec/issuerRef/kind", "Issuer"),
+				jsonpatch.NewPatch("add", "/spec/keyAlgorithm", "rsa"),
+				jsonpatch.NewPatch("add", "/spec/keyEncoding", "pkcs1"),
+				jsonpatch.NewPatch("add", "/spec/keySize", float64(2048)),
+			},
+		},
+		"defaults ed25519 certificate using v1alpha2": {
+			hook:      NewCertificateAdmissionHook(),
+			operation: admissionv1beta1.Update,
+			object: `{"apiVersion":"cert-manager.io/v1alpha2","kind":"Certificate","metadata":{"name":"test","creationTimestamp":null},
+				"spec":{"secretName":"test","issuerRef":{"name":"issuer","kind":"ClusterIssuer"},"dnsNames":["example.com"],
+				"duration":"24h0m0s","keyAlgorithm":"ed25519"},"status":{}}`,
+			expOps: []jsonpatch.Operation{
+				jsonpatch.NewPatch("add", "/spec/keyEncoding", "pkcs8"),
+			},
+		},
+		"does not change a fully specified certificate": {
+			hook:      NewCertificateAdmissionHook(),
+			operation: admissionv1beta1.Create,
+			object: `{"apiVersion":"cert-manager.io/v1alpha1","kind":"Certificate","metadata":{"name":"test","creationTimestamp":null},
+				"spec":{"secretName":"test","issuerRef":{"name":"issuer","kind":"Issuer"},"dnsNames":["example.com"],
+				"duration":"24h0m0s","keyAlgorithm":"ecdsa","keySize":384,"keyEncoding":"pkcs8"},"status":{}}`,
+		},
+		"defaults vault issuer auth": {
+			hook:      NewIssuerAdmissionHook(),
+			operation: admissionv1beta1.Create,
+			object: `{"apiVersion":"cert-manager.io/v1alpha1","kind":"Issuer","metadata":{"name":"test","creationTimestamp":null},
+				"spec":{"vault":{"server":"https://vault","path":"pki","auth":{"appRole":{"roleId":"role","secretRef":{"name":"secret"}}}}},"status":{}}`,
+			expOps: []jsonpatch.Operation{
+				jsonpatch.NewPatch("add", "/spec/vault/auth/appRole/path", "approle"),
+				jsonpatch.NewPatch("add", "/spec/vault/auth/tokenSecretRef", map[string]interface{}{"name": ""}),
+			},
+		},
+		"defaults acme solver service type on cluster issuer": {
+			hook:      NewClusterIssuerAdmissionHook(),
+			operation: admissionv1beta1.Create,
+			object: `{"apiVersion":"cert-manager.io/v1alpha2","kind":"ClusterIssuer","metadata":{"name":"test","creationTimestamp":null},
+				"spec":{"acme":{"server":"https://acme","privateKeySecretRef":{"name":"key"},"solvers":[{"http01":{"ingress":{}}}]}},"status":{}}`,
+			expOps: []jsonpatch.Operation{
+				jsonpatch.NewPatch("add", "/spec/acme/solvers/0/http01/ingress/serviceType", "NodePort"),
+			},
+		},
+		"ignores delete operations": {
+			hook:      NewCertificateAdmissionHook(),
+			operation: admissionv1beta1.Delete,
+			object:    `{"apiVersion":"cert-manager.io/v1alpha1","kind":"Certificate"}`,
+		},
+		"denies objects that cannot be decoded": {
+			hook:      NewCertificateAdmissionHook(),
+			operation: admissionv1beta1.Create,
+			object:    `{"apiVersion":"v1","kind":"Secret"}`,
+			expDenied: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := test.hook.Admit(&admissionv1beta1.AdmissionRequest{
+				UID:       "abc",
+				Operation: test.operation,
+				Object:    runtime.RawExtension{Raw: []byte(test.object)},
+			})
+			if resp.UID != "abc" {
+				t.Errorf("expected response UID to be %q but got %q", "abc", resp.UID)
+			}
+			if resp.Allowed == test.expDenied {
+				t.Fatalf("expected allowed=%t but got %t: %v", !test.expDenied, resp.Allowed, resp.Result)
+			}
+			if test.expDenied {
+				return
+			}
+
+			if len(test.expOps) == 0 {
+				if resp.Patch != nil || resp.PatchType != nil {
+					t.Errorf("expected no patch but got: %s", resp.Patch)
+				}
+				return
+			}
+			if resp.PatchType == nil || *resp.PatchType != admissionv1beta1.PatchTypeJSONPatch {
+				t.Errorf("expected patch type to be %q", admissionv1beta1.PatchTypeJSONPatch)
+			}
+
+			var ops []jsonpatch.Operation
+			if err := json.Unmarshal(resp.Patch, &ops); err != nil {
+				t.Fatalf("failed to decode patch: %v", err)
+			}
+			sort.Sort(jsonpatch.ByPath(ops))
+			if !reflect.DeepEqual(ops, test.expOps) {
+				t.Errorf("unexpected patch.\nexp=%v\ngot=%v", test.expOps, ops)
+			}
+		})
+	}
+}
+
+// TestDefaultedCertificateValidForIssuer ensures that the values persisted by
+// the webhook are accepted by the checks the certificates controller performs
+// against the referenced issuer before issuing.
+func TestDefaultedCertificateValidForIssuer(t *testing.T) {
+	tests := map[string]struct {
+		issuer v1alpha1.GenericIssuer
+	}{
+		"acme issuer": {
+			issuer: gen.Issuer("issuer", gen.SetIssuerACME(v1alpha1.ACMEIssuer{})),
+		},
+		"ca issuer": {
+			issuer: gen.Issuer("issuer", gen.SetIssuerCA(v1alpha1.CAIssuer{})),
+		},
+	}
+	object := []byte(`{"apiVersion":"cert-manager.io/v1alpha1","kind":"Certificate","metadata":{"name":"test","creationTimestamp":null},
+		"spec":{"secretName":"test","issuerRef":{"name":"issuer"},"dnsNames":["example.com"]},"status":{}}`)
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := NewCertificateAdmissionHook().Admit(&admissionv1beta1.AdmissionRequest{
+				UID:       "abc",
+				Operation: admissionv1beta1.Create,
+				Object:    runtime.RawExtension{Raw: object},
+			})
+			if !resp.Allowed {
+				t.Fatalf("expected certificate to be allowed: %v", resp.Result)
+			}
+
+			patch, err := jsonpatchapply.DecodePatch(resp.Patch)
+			if err != nil {
+				t.Fatalf("failed to decode patch: %v", err)
+			}
+			defaulted, err := patch.Apply(object)
+			if err != nil {
+				t.Fatalf("failed to apply patch: %v", err)
+			}
+
+			crt := &v1alpha1.Certificate{}
+			if err := json.Unmarshal(defaulted, crt); err != nil {
+				t.Fatalf("failed to decode defaulted certificate: %v", err)
+			}
+			if el := validation.ValidateCertificateForIssuer(crt, test.issuer); len(el) > 0 {
+				t.Errorf("expected defaulted certificate to be valid for issuer: %v", el.ToAggregate())
+			}
+		})
+	}
+}
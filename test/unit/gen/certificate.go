@@ -55,12 +55,36 @@ func SetCertificateDNSNames(dnsNames ...string) CertificateModifier {
 	}
 }
 
+func SetCertificateURISANs(uriSANs ...string) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.URISANs = uriSANs
+	}
+}
+
+func SetCertificateEmailSANs(emailSANs ...string) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.EmailSANs = emailSANs
+	}
+}
+
+func SetCertificateKeyUsages(usages ...v1alpha1.KeyUsage) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.Usages = usages
+	}
+}
+
 func SetCertificateCommonName(commonName string) CertificateModifier {
 	return func(crt *v1alpha1.Certificate) {
 		crt.Spec.CommonName = commonName
 	}
 }
 
+func SetCertificateSubject(subject *v1alpha1.X509Subject) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.Subject = subject
+	}
+}
+
 func SetCertificateIsCA(isCA bool) CertificateModifier {
 	return func(crt *v1alpha1.Certificate) {
 		crt.Spec.IsCA = isCA
@@ -73,6 +97,42 @@ func SetCertificateKeyAlgorithm(keyAlgorithm v1alpha1.KeyAlgorithm) CertificateM
 	}
 }
 
+func SetCertificateKeyEncoding(keyEncoding v1alpha1.KeyEncoding) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.KeyEncoding = keyEncoding
+	}
+}
+
+func SetCertificatePrivateKeyRotationPolicy(policy v1alpha1.PrivateKeyRotationPolicy) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.PrivateKey = &v1alpha1.CertificatePrivateKey{RotationPolicy: policy}
+	}
+}
+
+func SetCertificateAdditionalOutputFormats(formats ...v1alpha1.CertificateOutputFormatType) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.AdditionalOutputFormats = nil
+		for _, f := range formats {
+			crt.Spec.AdditionalOutputFormats = append(crt.Spec.AdditionalOutputFormats, v1alpha1.CertificateAdditionalOutputFormat{Type: f})
+		}
+	}
+}
+
+func SetCertificateSecretTemplate(annotations, labels map[string]string) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.SecretTemplate = &v1alpha1.CertificateSecretTemplate{
+			Annotations: annotations,
+			Labels:      labels,
+		}
+	}
+}
+
+func SetCertificateKeystores(keystores *v1alpha1.CertificateKeystores) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.Keystores = keystores
+	}
+}
+
 func SetCertificateKeySize(keySize int) CertificateModifier {
 	return func(crt *v1alpha1.Certificate) {
 		crt.Spec.KeySize = keySize
@@ -107,12 +167,48 @@ func SetCertificateLastFailureTime(p metav1.Time) CertificateModifier {
 	}
 }
 
+func SetCertificateFailedIssuanceAttempts(n int32) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.FailedIssuanceAttempts = n
+	}
+}
+
 func SetCertificateNotAfter(p metav1.Time) CertificateModifier {
 	return func(crt *v1alpha1.Certificate) {
 		crt.Status.NotAfter = &p
 	}
 }
 
+func SetCertificateNotBefore(p metav1.Time) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.NotBefore = &p
+	}
+}
+
+func SetCertificateRenewalTime(p metav1.Time) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.RenewalTime = &p
+	}
+}
+
+func SetCertificateSerialNumber(serialNumber string) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.SerialNumber = serialNumber
+	}
+}
+
+func SetCertificateFingerprint(fingerprint string) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.Fingerprint = fingerprint
+	}
+}
+
+func SetCertificateRevision(revision int) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.Revision = &revision
+	}
+}
+
 func SetCertificateOrganization(orgs ...string) CertificateModifier {
 	return func(ch *v1alpha1.Certificate) {
 		ch.Spec.Organization = orgs
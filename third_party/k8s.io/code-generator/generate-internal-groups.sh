@@ -0,0 +1,86 @@
+#!/usr/bin/env bash
+
+# Copyright 2017 The Kubernetes Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# With modifications for jetstack/cert-manager under the Apache2 license detailed
+# in the repo root.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+# generate-internal-groups generates the deepcopy, defaulting and conversion
+# functions for a project with internal types, e.g. a project that serves
+# multiple versions of the same group through a conversion webhook.
+
+if [ "$#" -lt 4 ] || [ "${1}" == "--help" ]; then
+  cat <<EOF2
+Usage: $(basename $0) <generators> <internal-apis-package> <external-apis-package> <groups-versions> ...
+
+  <generators>            the generators comma separated to run (deepcopy,defaulter,conversion) or "all".
+  <internal-apis-package> the internal types dir (e.g. github.com/example/project/pkg/apis).
+  <external-apis-package> the external types dir (e.g. github.com/example/project/pkg/apis).
+  <groups-versions>       the groups and their versions in the format "groupA:v1,v2 groupB:v1 groupC:v2", relative
+                          to <api-package>.
+  ...                     arbitrary flags passed to all generator binaries.
+
+Examples:
+  $(basename $0) all                   github.com/example/project/pkg/apis github.com/example/project/pkg/apis "foo:v1 bar:v1alpha1,v1beta1"
+  $(basename $0) deepcopy,conversion   github.com/example/project/pkg/apis github.com/example/project/pkg/apis "foo:v1 bar:v1alpha1,v1beta1"
+EOF2
+  exit 0
+fi
+
+GENS="$1"
+INT_APIS_PKG="$2"
+EXT_APIS_PKG="$3"
+GROUPS_WITH_VERSIONS="$4"
+shift 4
+
+function codegen::join() { local IFS="$1"; shift; echo "$*"; }
+
+# enumerate group versions
+ALL_FQ_APIS=() # e.g. k8s.io/kubernetes/pkg/apis/apps k8s.io/api/apps/v1
+INT_FQ_APIS=() # e.g. k8s.io/kubernetes/pkg/apis/apps
+EXT_FQ_APIS=() # e.g. k8s.io/api/apps/v1
+for GVs in ${GROUPS_WITH_VERSIONS}; do
+  IFS=: read G Vs <<<"${GVs}"
+
+  if [ -n "${INT_APIS_PKG}" ]; then
+    ALL_FQ_APIS+=(${INT_APIS_PKG}/${G})
+    INT_FQ_APIS+=(${INT_APIS_PKG}/${G})
+  fi
+
+  # enumerate versions
+  for V in ${Vs//,/ }; do
+    ALL_FQ_APIS+=(${EXT_APIS_PKG}/${G}/${V})
+    EXT_FQ_APIS+=(${EXT_APIS_PKG}/${G}/${V})
+  done
+done
+
+if [ "${GENS}" = "all" ] || grep -qw "deepcopy" <<<"${GENS}"; then
+  echo "Generating deepcopy funcs"
+  deepcopy-gen --input-dirs $(codegen::join , "${ALL_FQ_APIS[@]}") -O zz_generated.deepcopy --bounding-dirs ${INT_APIS_PKG},${EXT_APIS_PKG} "$@"
+fi
+
+if [ "${GENS}" = "all" ] || grep -qw "defaulter" <<<"${GENS}"; then
+  echo "Generating defaulters"
+  defaulter-gen --input-dirs $(codegen::join , "${EXT_FQ_APIS[@]}") -O zz_generated.defaults "$@"
+fi
+
+if [ "${GENS}" = "all" ] || grep -qw "conversion" <<<"${GENS}"; then
+  echo "Generating conversions"
+  conversion-gen --input-dirs $(codegen::join , "${ALL_FQ_APIS[@]}") -O zz_generated.conversion "$@"
+fi